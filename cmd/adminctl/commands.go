@@ -0,0 +1,330 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	"github.com/Mockird31/avito_tech/internal/stats"
+	"github.com/Mockird31/avito_tech/internal/team"
+	"github.com/Mockird31/avito_tech/internal/user"
+	"github.com/Mockird31/avito_tech/migrations"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
+
+	appRouter "github.com/Mockird31/avito_tech/internal/app/router"
+)
+
+// commandSet - команды, работающие через те же usecase'ы, что и сервис. События,
+// записанные в outbox (деактивация, переназначение), публикует relay запущенного сервиса.
+type commandSet struct {
+	teamUse  team.IUsecase
+	userUse  user.IUsecase
+	prUse    pullrequest.IUsecase
+	statsUse stats.IUsecase
+	out      *printer
+}
+
+func newCommandSet(conn *sql.DB, out *printer) *commandSet {
+	return &commandSet{
+		teamUse:  appRouter.TeamUsecase(conn),
+		userUse:  appRouter.UserUsecase(conn),
+		prUse:    appRouter.PullRequestUsecase(conn),
+		statsUse: appRouter.StatsUsecase(conn),
+		out:      out,
+	}
+}
+
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(io.Discard)
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s: %s", errUsage, fs.Name(), err)
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("%w: %s: unexpected argument %q", errUsage, fs.Name(), fs.Arg(0))
+	}
+	return nil
+}
+
+func (c *commandSet) teams(ctx context.Context, args []string) error {
+	if err := parseFlags(flag.NewFlagSet("teams", flag.ContinueOnError), args); err != nil {
+		return err
+	}
+
+	teams, err := c.teamUse.ListTeams(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.out.print(teams, func(w *tabwriter.Writer) {
+		writeTeams(w, teams)
+	})
+}
+
+func (c *commandSet) deactivate(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("deactivate", flag.ContinueOnError)
+	teamName := fs.String("team", "", "team name")
+	userIds := fs.String("users", "", "comma separated user ids")
+	dryRun := fs.Bool("dry-run", false, "only show planned reassignments")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *teamName == "" || *userIds == "" {
+		return fmt.Errorf("%w: deactivate: -team and -users are required", errUsage)
+	}
+
+	result, err := c.userUse.DeactivateTeamUsers(ctx, &entity.DeactivateUsers{
+		TeamName: *teamName,
+		UserIds:  strings.Split(*userIds, ","),
+		DryRun:   *dryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.out.print(result, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "PULL REQUEST\tOLD REVIEWER\tNEW REVIEWER\tRESULT\tREASON")
+		for _, r := range result.Reassignments {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", r.PullRequestId, r.OldReviewerId, stringOrDash(r.NewReviewerId), r.Result, r.Reason)
+		}
+		if s := result.Summary; s != nil {
+			fmt.Fprintf(w, "\naffected: %d, reassigned: %d, without replacement: %d, dry run: %t\n",
+				s.AffectedPullRequests, s.Reassigned, s.WithoutReplacement, result.DryRun)
+		}
+	})
+}
+
+func (c *commandSet) reassign(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("reassign", flag.ContinueOnError)
+	prId := fs.String("pr", "", "pull request id")
+	oldReviewerId := fs.String("old", "", "reviewer to replace")
+	newReviewerId := fs.String("new", "", "specific replacement, by default the least loaded team member")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *prId == "" || *oldReviewerId == "" {
+		return fmt.Errorf("%w: reassign: -pr and -old are required", errUsage)
+	}
+
+	result, err := c.prUse.ReassignPullRequest(ctx, &entity.PullRequestReassignRequest{
+		Id:            *prId,
+		OldReviewerId: *oldReviewerId,
+		NewReviewerId: *newReviewerId,
+	})
+	if err != nil {
+		return err
+	}
+
+	response := &entity.PullRequestReassignResponse{
+		PullRequest:        result.PullRequest,
+		ReplacedBy:         result.ReplacedBy,
+		Outcome:            result.Outcome,
+		ExcludedCandidates: result.ExcludedCandidates,
+	}
+	return c.out.print(response, func(w *tabwriter.Writer) {
+		fmt.Fprintf(w, "pull request:\t%s\n", result.PullRequest.Id)
+		fmt.Fprintf(w, "outcome:\t%s\n", result.Outcome)
+		fmt.Fprintf(w, "replaced by:\t%s\n", dashIfEmpty(result.ReplacedBy))
+		fmt.Fprintf(w, "reviewers:\t%s\n", strings.Join(result.PullRequest.AssignedReviewersIds, ", "))
+		if e := result.ExcludedCandidates; e != nil {
+			fmt.Fprintf(w, "excluded:\tauthor %d, inactive %d, already assigned %d, at capacity %d\n",
+				e.Author, e.Inactive, e.AlreadyAssigned, e.AtCapacity)
+		}
+	})
+}
+
+type migrationState struct {
+	Version int32  `json:"version"`
+	Latest  int32  `json:"latest"`
+	Applied bool   `json:"applied"`
+	Info    string `json:"-"`
+}
+
+func migrate(cfg *config.Config, out *printer, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	statusOnly := fs.Bool("status", false, "only show current and latest versions")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	migrator, err := migrations.NewMigrator(postgres.DSN(cfg.Postgres))
+	if err != nil {
+		return fmt.Errorf("create migrator: %w", err)
+	}
+
+	state := &migrationState{}
+	if state.Version, state.Latest, state.Info, err = migrator.Info(); err != nil {
+		return fmt.Errorf("get migration info: %w", err)
+	}
+
+	if !*statusOnly && state.Version < state.Latest {
+		if err := migrator.Migrate(); err != nil {
+			return fmt.Errorf("migrate: %w", err)
+		}
+		state.Applied = true
+		if state.Version, state.Latest, state.Info, err = migrator.Info(); err != nil {
+			return fmt.Errorf("get migration info: %w", err)
+		}
+	}
+
+	return out.print(state, func(w *tabwriter.Writer) {
+		fmt.Fprint(w, state.Info)
+		fmt.Fprintf(w, "\nversion %d of %d, applied now: %t\n", state.Version, state.Latest, state.Applied)
+	})
+}
+
+func (c *commandSet) stats(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	overdue := fs.Bool("overdue", false, "show overdue reviews instead of assignment counts")
+	teamName := fs.String("team", "", "team of the pull request author, only with -overdue")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	if *overdue {
+		reviews, err := c.statsUse.GetOverdueReviews(ctx, *teamName)
+		if err != nil {
+			return err
+		}
+		return c.out.print(reviews, func(w *tabwriter.Writer) {
+			fmt.Fprintln(w, "PULL REQUEST\tREVIEWER\tTEAM\tASSIGNED AT\tOVERDUE AT\tSLA HOURS")
+			for _, r := range reviews {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%d\n", r.PullRequestId, r.ReviewerId, r.TeamName,
+					r.AssignedAt.Format(time.RFC3339), r.OverdueAt.Format(time.RFC3339), r.ReviewSlaHours)
+			}
+		})
+	}
+
+	if *teamName != "" {
+		return fmt.Errorf("%w: stats: -team is supported only with -overdue", errUsage)
+	}
+
+	counts, err := c.statsUse.GetAssignmentsStatsByReviewers(ctx)
+	if err != nil {
+		return err
+	}
+	return c.out.print(counts, func(w *tabwriter.Writer) {
+		writeAssignments(w, counts)
+	})
+}
+
+// exportData - полная выгрузка. Pull request'ы читаются по одному, чтобы в выгрузку попали
+// ревьюверы и метаданные, поэтому на больших базах команда работает заметно дольше остальных.
+type exportData struct {
+	ExportedAt      time.Time                     `json:"exported_at"`
+	Teams           []*entity.TeamSummary         `json:"teams"`
+	Users           []*entity.User                `json:"users"`
+	PullRequests    []*entity.PullRequest         `json:"pull_requests"`
+	AssignmentStats []*entity.UserAssignmentCount `json:"assignment_stats"`
+}
+
+func (c *commandSet) export(ctx context.Context, args []string) (err error) {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	outPath := fs.String("out", "", "write to file instead of stdout")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	data, err := c.collectExport(ctx)
+	if err != nil {
+		return err
+	}
+
+	out := c.out
+	if *outPath != "" {
+		f, err := os.Create(*outPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if closeErr := f.Close(); closeErr != nil && err == nil {
+				err = closeErr
+			}
+		}()
+		out = newPrinter(f, c.out.format)
+	}
+
+	return out.print(data, func(w *tabwriter.Writer) {
+		fmt.Fprintf(w, "exported at %s\n\n", data.ExportedAt.Format(time.RFC3339))
+		writeTeams(w, data.Teams)
+		fmt.Fprintln(w)
+
+		fmt.Fprintln(w, "USER\tUSERNAME\tTEAM\tACTIVE")
+		for _, u := range data.Users {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", u.UserId, u.Username, u.TeamName, u.IsActive)
+		}
+		fmt.Fprintln(w)
+
+		fmt.Fprintln(w, "PULL REQUEST\tNAME\tAUTHOR\tSTATUS\tREVIEWERS\tPRIORITY\tLABELS")
+		for _, pr := range data.PullRequests {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", pr.Id, pr.PrName, pr.AuthorId, pr.Status,
+				strings.Join(pr.AssignedReviewersIds, ","), pr.Priority, strings.Join(pr.Labels, ","))
+		}
+		fmt.Fprintln(w)
+
+		writeAssignments(w, data.AssignmentStats)
+	})
+}
+
+func (c *commandSet) collectExport(ctx context.Context) (*exportData, error) {
+	data := &exportData{ExportedAt: time.Now().UTC()}
+
+	var err error
+	if data.Teams, err = c.teamUse.ListTeams(ctx); err != nil {
+		return nil, err
+	}
+
+	data.Users = make([]*entity.User, 0)
+	for offset := 0; ; offset += entity.MaxPageLimit {
+		page, err := c.userUse.ListUsers(ctx, &entity.UserListFilter{Limit: entity.MaxPageLimit, Offset: offset})
+		if err != nil {
+			return nil, err
+		}
+		data.Users = append(data.Users, page.Users...)
+		if offset+len(page.Users) >= page.Total || len(page.Users) == 0 {
+			break
+		}
+	}
+
+	data.PullRequests = make([]*entity.PullRequest, 0)
+	afterId := ""
+	for {
+		page, err := c.prUse.ExportPullRequests(ctx, afterId, entity.MaxPageLimit)
+		if err != nil {
+			return nil, err
+		}
+		data.PullRequests = append(data.PullRequests, page...)
+		if len(page) < entity.MaxPageLimit {
+			break
+		}
+		afterId = page[len(page)-1].Id
+	}
+
+	if data.AssignmentStats, err = c.statsUse.GetAssignmentsStatsByReviewers(ctx); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func writeTeams(w io.Writer, teams []*entity.TeamSummary) {
+	fmt.Fprintln(w, "TEAM\tMEMBERS\tACTIVE\tREVIEWERS\tMAX OPEN REVIEWS\tSLA HOURS\tAUTO REASSIGN")
+	for _, t := range teams {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d..%d\t%s\t%s\t%t\n", t.TeamName, t.Members, t.ActiveMembers, t.MinReviewers, t.MaxReviewers,
+			intOrDash(t.MaxOpenReviews), intOrDash(t.ReviewSlaHours), t.AutoReassign)
+	}
+}
+
+func writeAssignments(w io.Writer, counts []*entity.UserAssignmentCount) {
+	fmt.Fprintln(w, "REVIEWER\tASSIGNMENTS")
+	for _, c := range counts {
+		fmt.Fprintf(w, "%s\t%d\n", c.UserId, c.Count)
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Mockird31/avito_tech/config"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
+)
+
+const usage = `usage: adminctl [-format table|json] <command> [flags]
+
+commands:
+  teams                                        список команд с числом участников и настройками ревью
+  deactivate -team T -users u1,u2 [-dry-run]   деактивирует пользователей и переназначает их ревью
+  reassign -pr ID -old U [-new U]              переназначает ревьювера pull request'а
+  migrate [-status]                            применяет миграции или показывает их состояние
+  stats [-overdue] [-team T]                   статистика назначений или просроченные ревью
+  export [-out FILE]                           выгружает команды, пользователей и pull request'ы
+
+Конфигурация берется из тех же переменных окружения, что и у сервиса.
+`
+
+// errUsage - неверные аргументы командной строки, код выхода 2.
+var errUsage = errors.New("invalid arguments")
+
+func main() {
+	flag.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	format := flag.String("format", formatTable, "output format: table or json")
+	flag.Parse()
+
+	if flag.NArg() == 0 || (*format != formatTable && *format != formatJSON) {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err := run(flag.Arg(0), flag.Args()[1:], *format); err != nil {
+		fmt.Fprintf(os.Stderr, "adminctl: %s\n", err)
+		if errors.Is(err, errUsage) {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
+
+var commands = map[string]bool{
+	"teams": true, "deactivate": true, "reassign": true, "migrate": true, "stats": true, "export": true,
+}
+
+func run(command string, args []string, format string) error {
+	if !commands[command] {
+		flag.Usage()
+		return fmt.Errorf("%w: unknown command %q", errUsage, command)
+	}
+
+	cfg, err := config.NewConfig()
+	if err != nil {
+		return fmt.Errorf("config: %w", err)
+	}
+
+	logger, err := loggerPkg.NewZapLogger()
+	if err != nil {
+		return fmt.Errorf("logger: %w", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	ctx = loggerPkg.LoggerToContext(ctx, logger)
+
+	out := newPrinter(os.Stdout, format)
+
+	if command == "migrate" {
+		return migrate(cfg, out, args)
+	}
+
+	conn, err := postgres.ConnectPostgres(cfg.Postgres)
+	if err != nil {
+		return fmt.Errorf("connect to postgres: %w", err)
+	}
+	defer func(conn *sql.DB) {
+		_ = conn.Close()
+	}(conn)
+
+	cmd := newCommandSet(conn, out)
+	switch command {
+	case "teams":
+		return cmd.teams(ctx, args)
+	case "deactivate":
+		return cmd.deactivate(ctx, args)
+	case "reassign":
+		return cmd.reassign(ctx, args)
+	case "stats":
+		return cmd.stats(ctx, args)
+	default:
+		return cmd.export(ctx, args)
+	}
+}
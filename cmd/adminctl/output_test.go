@@ -0,0 +1,81 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"testing"
+	"text/tabwriter"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrinter(t *testing.T) {
+	limit := 3
+	teams := []*entity.TeamSummary{
+		{TeamName: "backend", Members: 4, ActiveMembers: 3, MaxOpenReviews: &limit, MinReviewers: 1, MaxReviewers: 5},
+		{TeamName: "ml", Members: 1, ActiveMembers: 1, AutoReassign: true, MinReviewers: 0, MaxReviewers: 2},
+	}
+	table := func(w *tabwriter.Writer) { writeTeams(w, teams) }
+
+	tests := []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{
+			name:   "table",
+			format: formatTable,
+			want: "TEAM     MEMBERS  ACTIVE  REVIEWERS  MAX OPEN REVIEWS  SLA HOURS  AUTO REASSIGN\n" +
+				"backend  4        3       1..5       3                 -          false\n" +
+				"ml       1        1       0..2       -                 -          true\n",
+		},
+		{
+			name:   "json",
+			format: formatJSON,
+			want: `[
+  {
+    "team_name": "backend",
+    "members": 4,
+    "active_members": 3,
+    "max_open_reviews": 3,
+    "review_sla_hours": null,
+    "auto_reassign": false,
+    "min_reviewers": 1,
+    "max_reviewers": 5
+  },
+  {
+    "team_name": "ml",
+    "members": 1,
+    "active_members": 1,
+    "max_open_reviews": null,
+    "review_sla_hours": null,
+    "auto_reassign": true,
+    "min_reviewers": 0,
+    "max_reviewers": 2
+  }
+]
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, newPrinter(&buf, tt.format).print(teams, table))
+			assert.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestUsageErrors(t *testing.T) {
+	err := run("unknown", nil, formatTable)
+	assert.ErrorIs(t, err, errUsage)
+
+	err = parseFlags(flag.NewFlagSet("teams", flag.ContinueOnError), []string{"extra"})
+	assert.ErrorIs(t, err, errUsage)
+	assert.Contains(t, err.Error(), fmt.Sprintf("unexpected argument %q", "extra"))
+}
@@ -2,13 +2,72 @@ package config
 
 import (
 	"errors"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
 
 type Config struct {
-	Port     int `env:"PORT,required"`
+	Port int `env:"PORT,required"`
+	// GrpcPort - порт gRPC API, 0 отключает его
+	GrpcPort int `env:"GRPC_PORT" envDefault:"9090"`
 	Postgres PostgresConfig
+	// ReconcileInterval - период фоновой сверки ревьюверов, 0 отключает ее
+	ReconcileInterval time.Duration `env:"RECONCILE_INTERVAL" envDefault:"1m"`
+	// SlaCheckInterval - период проверки SLA ревью, 0 отключает ее
+	SlaCheckInterval time.Duration `env:"SLA_CHECK_INTERVAL" envDefault:"5m"`
+	Webhook          WebhookConfig
+	Outbox           OutboxConfig
+	Integration      IntegrationConfig
+	CodeHost         CodeHostConfig
+	Notification     NotificationConfig
+}
+
+type WebhookConfig struct {
+	// Workers - сколько подписок на одно событие доставляются параллельно
+	Workers     int           `env:"WEBHOOK_WORKERS" envDefault:"4"`
+	// MaxAttempts - после стольких неуспешных попыток подписка больше не получает событие;
+	// между попытками событие ждет в outbox с задержкой OUTBOX_BASE_BACKOFF..OUTBOX_MAX_BACKOFF
+	MaxAttempts int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"5"`
+	Timeout     time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"5s"`
+
+	// AllowPrivateTargets - разрешить доставку на loopback и адреса внутренних сетей (только для локальной разработки)
+	AllowPrivateTargets bool `env:"WEBHOOK_ALLOW_PRIVATE_TARGETS" envDefault:"false"`
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" envDefault:"1s"`
+	BatchSize    int           `env:"OUTBOX_BATCH_SIZE" envDefault:"100"`
+	// Lease - на сколько взятое relay'ем событие скрывается от других экземпляров сервиса
+	Lease       time.Duration `env:"OUTBOX_LEASE" envDefault:"5m"`
+	BaseBackoff time.Duration `env:"OUTBOX_BASE_BACKOFF" envDefault:"1s"`
+	MaxBackoff  time.Duration `env:"OUTBOX_MAX_BACKOFF" envDefault:"5m"`
+	Sinks       []string      `env:"OUTBOX_SINKS" envDefault:"webhook,log"`
+}
+
+// IntegrationConfig - секреты входящих вебхуков GitHub и GitLab. Без секрета все запросы провайдера отклоняются.
+type IntegrationConfig struct {
+	GithubWebhookSecret string `env:"GITHUB_WEBHOOK_SECRET"`
+	GitlabWebhookToken  string `env:"GITLAB_WEBHOOK_TOKEN"`
+}
+
+// CodeHostConfig - доступ к API хостинга для синхронизации ревьюверов (sink "codehost" в OUTBOX_SINKS).
+type CodeHostConfig struct {
+	GithubToken  string        `env:"GITHUB_TOKEN"`
+	GithubApiUrl string        `env:"GITHUB_API_URL" envDefault:"https://api.github.com"`
+	Timeout      time.Duration `env:"CODEHOST_TIMEOUT" envDefault:"10s"`
+}
+
+// NotificationConfig - уведомления ревьюверов (sink "notify" в OUTBOX_SINKS) и ежедневный дайджест.
+type NotificationConfig struct {
+	// Notifiers - каналы доставки: chat (входящий вебхук Slack / Mattermost) и log
+	Notifiers      []string `env:"NOTIFY_NOTIFIERS" envDefault:"log"`
+	ChatWebhookUrl string   `env:"NOTIFY_CHAT_WEBHOOK_URL"`
+	// ChatDirect - отправлять сообщение в личный канал "@username" вместо канала вебхука
+	ChatDirect  bool          `env:"NOTIFY_CHAT_DIRECT" envDefault:"false"`
+	ChatTimeout time.Duration `env:"NOTIFY_CHAT_TIMEOUT" envDefault:"5s"`
+	// DigestAt - время рассылки дайджеста открытых ревью в UTC ("HH:MM"), пустое значение отключает ее
+	DigestAt string `env:"NOTIFY_DIGEST_AT" envDefault:"09:00"`
 }
 
 type PostgresConfig struct {
@@ -0,0 +1,134 @@
+//go:build e2e
+
+package e2e
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	"go.uber.org/zap"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	outboxRepo "github.com/Mockird31/avito_tech/internal/outbox/repository"
+	prRepo "github.com/Mockird31/avito_tech/internal/pullRequest/repository"
+	teamRepo "github.com/Mockird31/avito_tech/internal/team/repository"
+	"github.com/Mockird31/avito_tech/internal/user"
+	userRepo "github.com/Mockird31/avito_tech/internal/user/repository"
+	userUse "github.com/Mockird31/avito_tech/internal/user/usecase"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
+)
+
+const (
+	dataCsvDir = "../data_csv"
+	// seededTeam - команда из data_csv, половина которой деактивируется в замерах
+	seededTeam = "test_backend"
+	// deactivateTarget - целевое время массовой деактивации из readme
+	deactivateTarget = 100 * time.Millisecond
+)
+
+// errRollback откатывает транзакцию замера, чтобы каждая итерация работала с исходными данными.
+var errRollback = errors.New("rollback")
+
+// seedCsvFiles кладет data_csv туда, откуда их читает миграция 003_insert_data.
+func seedCsvFiles() testcontainers.CustomizeRequestOption {
+	names := []string{"teams.csv", "users.csv", "pull_requests.csv", "pull_request_reviewers.csv"}
+	files := make([]testcontainers.ContainerFile, 0, len(names))
+	for _, name := range names {
+		files = append(files, testcontainers.ContainerFile{
+			HostFilePath:      filepath.Join(dataCsvDir, name),
+			ContainerFilePath: "/home/csv/" + name,
+			FileMode:          0o644,
+		})
+	}
+	return testcontainers.WithFiles(files...)
+}
+
+func newSeededUserUsecase(tb testing.TB) (user.IUsecase, *postgres.Transactor, *sql.DB) {
+	tb.Helper()
+
+	db, err := postgres.ConnectPostgres(postgresConfigFromDSN(dsnGlobal))
+	require.NoError(tb, err)
+	tb.Cleanup(func() { _ = db.Close() })
+
+	tx := postgres.NewTransactor(db)
+	uu := userUse.NewUsecase(userRepo.NewRepository(db), prRepo.NewRepository(db), teamRepo.NewRepository(db), outboxRepo.NewRepository(db), tx)
+	return uu, tx, db
+}
+
+// halfOfTeam возвращает первую половину активных участников команды из data_csv.
+func halfOfTeam(tb testing.TB, db *sql.DB, teamName string) []string {
+	tb.Helper()
+
+	rows, err := db.Query(`SELECT id FROM "user" WHERE team_name = $1 AND is_active AND deleted_at IS NULL ORDER BY id`, teamName)
+	require.NoError(tb, err)
+	defer rows.Close()
+
+	ids := make([]string, 0)
+	for rows.Next() {
+		var id string
+		require.NoError(tb, rows.Scan(&id))
+		ids = append(ids, id)
+	}
+	require.NoError(tb, rows.Err())
+	require.NotEmpty(tb, ids, "team %s is not seeded from data_csv", teamName)
+	return ids[:(len(ids)+1)/2]
+}
+
+// deactivateInTx выполняет деактивацию в транзакции и откатывает ее, возвращая время самого вызова.
+func deactivateInTx(ctx context.Context, uu user.IUsecase, tx *postgres.Transactor, req *entity.DeactivateUsers) (*entity.DeactivateUsers, time.Duration, error) {
+	var (
+		res     *entity.DeactivateUsers
+		elapsed time.Duration
+	)
+	err := tx.WithinTx(ctx, func(ctx context.Context) error {
+		started := time.Now()
+		var err error
+		res, err = uu.DeactivateTeamUsers(ctx, req)
+		elapsed = time.Since(started)
+		if err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if !errors.Is(err, errRollback) {
+		return nil, 0, err
+	}
+	return res, elapsed, nil
+}
+
+func TestE2E_DeactivateTeamUsers_SeededData(t *testing.T) {
+	uu, tx, db := newSeededUserUsecase(t)
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+	ids := halfOfTeam(t, db, seededTeam)
+
+	// первый вызов прогревает соединение и планы запросов
+	_, _, err := deactivateInTx(ctx, uu, tx, &entity.DeactivateUsers{TeamName: seededTeam, UserIds: ids})
+	require.NoError(t, err)
+
+	res, elapsed, err := deactivateInTx(ctx, uu, tx, &entity.DeactivateUsers{TeamName: seededTeam, UserIds: ids})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Reassignments)
+	require.Less(t, elapsed, deactivateTarget, "deactivating %d users took %s", len(ids), elapsed)
+}
+
+func BenchmarkE2E_DeactivateTeamUsers(b *testing.B) {
+	uu, tx, db := newSeededUserUsecase(b)
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+	ids := halfOfTeam(b, db, seededTeam)
+
+	b.ResetTimer()
+	var total time.Duration
+	for i := 0; i < b.N; i++ {
+		_, elapsed, err := deactivateInTx(ctx, uu, tx, &entity.DeactivateUsers{TeamName: seededTeam, UserIds: ids})
+		require.NoError(b, err)
+		total += elapsed
+	}
+	b.ReportMetric(float64(total.Microseconds())/float64(b.N)/1000, "ms/deactivation")
+}
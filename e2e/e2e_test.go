@@ -23,6 +23,7 @@ import (
 	"github.com/Mockird31/avito_tech/pkg/postgres"
 
 	"github.com/Mockird31/avito_tech/internal/middleware"
+	outboxRepo "github.com/Mockird31/avito_tech/internal/outbox/repository"
 	prHttp "github.com/Mockird31/avito_tech/internal/pullRequest/delivery/http"
 	prRepo "github.com/Mockird31/avito_tech/internal/pullRequest/repository"
 	prUse "github.com/Mockird31/avito_tech/internal/pullRequest/usecase"
@@ -51,6 +52,7 @@ func TestMain(m *testing.M) {
 	container, err := tcpostgres.RunContainer(ctx,
 		testcontainers.WithImage("postgres:16-alpine"),
 		tcpostgres.WithInitScripts(),
+		seedCsvFiles(),
 		tcpostgres.WithDatabase("appdb"),
 		tcpostgres.WithUsername("appuser"),
 		tcpostgres.WithPassword("apppass"),
@@ -137,10 +139,12 @@ func newTestServer(t *testing.T) (*httptest.Server, *http.Client) {
 	ur := userRepo.NewRepository(db)
 	prr := prRepo.NewRepository(db)
 	sr := statsRepo.NewRepository(db)
+	or := outboxRepo.NewRepository(db)
+	tx := postgres.NewTransactor(db)
 
 	tu := teamUse.NewUsecase(tr, ur)
-	uu := userUse.NewUsecase(ur, prr)
-	pu := prUse.NewUsecase(prr, ur, tr)
+	uu := userUse.NewUsecase(ur, prr, tr, or, tx)
+	pu := prUse.NewUsecase(prr, ur, tr, or, tx)
 	su := statsUse.NewUsecase(sr)
 
 	th := teamHttp.NewHandler(tu)
@@ -290,5 +294,5 @@ func TestE2E_Stats_AssignmentsByReviewers(t *testing.T) {
 
 	var stats entity.AssignmentStatsResponse
 	require.NoError(t, json.Unmarshal(resp.Body, &stats))
-	require.NotEmpty(t, stats.Title)
+	require.NotEmpty(t, stats.Statistics)
 }
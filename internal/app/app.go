@@ -2,25 +2,29 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/Mockird31/avito_tech/config"
-	"github.com/Mockird31/avito_tech/pkg/logger"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
 	"github.com/Mockird31/avito_tech/pkg/postgres"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	appRouter "github.com/Mockird31/avito_tech/internal/app/router"
 	"github.com/Mockird31/avito_tech/internal/middleware"
 )
 
 func Run(cfg *config.Config) {
-	logger, err := logger.NewZapLogger()
+	logger, err := loggerPkg.NewZapLogger()
 	if err != nil {
 		logger.Error("Error creating logger:", zap.Error(err))
 		return
@@ -31,6 +35,7 @@ func Run(cfg *config.Config) {
 		logger.Error("failed to connect to postgres:", zap.Error(err))
 		return
 	}
+	logger.Info("Connected to Postgres")
 	defer func() {
 		if err := postgresConn.Close(); err != nil {
 			logger.Error("Error closing Postgres:", zap.Error(err))
@@ -43,36 +48,117 @@ func Run(cfg *config.Config) {
 		return
 	}
 
+	// Фоновые задачи останавливаются после HTTP и gRPC серверов, и Run ждет их завершения,
+	// чтобы relay и периодические задачи не обрывались посреди захваченной пачки.
+	workerCtx, cancelWorkers := context.WithCancel(loggerPkg.LoggerToContext(context.Background(), logger))
+	var workers sync.WaitGroup
+	defer func() {
+		cancelWorkers()
+		workers.Wait()
+	}()
+	runWorker := func(run func(ctx context.Context)) {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			run(workerCtx)
+		}()
+	}
+
+	relay, err := appRouter.OutboxRelay(postgresConn, cfg)
+	if err != nil {
+		logger.Error("Error creating outbox relay:", zap.Error(err))
+		return
+	}
+	runWorker(relay.Run)
+
+	teamUse := appRouter.TeamUsecase(postgresConn)
+	userUse := appRouter.UserUsecase(postgresConn)
+	prUse := appRouter.PullRequestUsecase(postgresConn)
+	statsUse := appRouter.StatsUsecase(postgresConn)
+
+	if cfg.ReconcileInterval > 0 {
+		runWorker(appRouter.ReconcilerWorker(prUse, cfg.ReconcileInterval).Run)
+	}
+	if cfg.SlaCheckInterval > 0 {
+		runWorker(appRouter.SlaWorker(prUse, cfg.SlaCheckInterval).Run)
+	}
+
+	notificationUse, err := appRouter.NotificationUsecase(postgresConn, cfg.Notification)
+	if err != nil {
+		logger.Error("Error creating notifications:", zap.Error(err))
+		return
+	}
+	if cfg.Notification.DigestAt != "" {
+		digest, err := appRouter.DigestWorker(notificationUse, cfg.Notification)
+		if err != nil {
+			logger.Error("Error creating digest worker:", zap.Error(err))
+			return
+		}
+		runWorker(digest.Run)
+	}
+
+	eventStreamUse, eventStreamListener := appRouter.EventStream(postgresConn, cfg.Postgres)
+	runWorker(eventStreamListener.Run)
+
 	r := mux.NewRouter()
 
 	r.Use(middleware.LoggerMiddleware(logger))
 
-	appRouter.TeamRouter(r, postgresConn)
-	appRouter.UserRouter(r, postgresConn)
-	appRouter.PullRequestRouter(r, postgresConn)
-	appRouter.StatsRouter(r, postgresConn)
+	appRouter.TeamRouter(r, teamUse)
+	appRouter.UserRouter(r, userUse)
+	appRouter.PullRequestRouter(r, prUse)
+	appRouter.StatsRouter(r, statsUse)
+	appRouter.V2Router(r, teamUse, userUse, prUse, statsUse)
+	appRouter.WebhookRouter(r, postgresConn)
+	appRouter.IntegrationRouter(r, postgresConn, prUse, cfg.Integration)
+	appRouter.NotificationRouter(r, notificationUse)
+	appRouter.EventStreamRouter(r, eventStreamUse)
+
+	var grpcSrv *grpc.Server
+	if cfg.GrpcPort > 0 {
+		lis, err := net.Listen("tcp", fmt.Sprintf(":%d", cfg.GrpcPort))
+		if err != nil {
+			logger.Error("Error listening grpc port:", zap.Error(err))
+			return
+		}
+
+		grpcSrv = appRouter.GrpcServer(logger, teamUse, userUse, prUse, statsUse)
+		go func() {
+			if err := grpcSrv.Serve(lis); err != nil {
+				logger.Error("Error serving grpc:", zap.Error(err))
+			}
+		}()
+	}
 
 	srv := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.Port),
 		Handler: r,
 	}
 
-	err = srv.ListenAndServe()
-	if err != nil {
-		logger.Error("Error starting server:", zap.Error(err))
-		return
-	}
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- srv.ListenAndServe()
+	}()
 
 	shutDown := make(chan os.Signal, 1)
 	signal.Notify(shutDown, syscall.SIGINT, syscall.SIGTERM)
-	<-shutDown
+	select {
+	case <-shutDown:
+	case err := <-serverErr:
+		logger.Error("Error starting server:", zap.Error(err))
+	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	err = srv.Shutdown(ctx)
-	if err != nil {
+	// Открытые потоки /events/stream сами не завершаются, поэтому по истечении таймаута соединения закрываются.
+	if err := srv.Shutdown(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		logger.Error("Error shutting down server:", zap.Error(err))
-		os.Exit(1)
+		if err := srv.Close(); err != nil {
+			logger.Error("Error closing server:", zap.Error(err))
+		}
+	}
+	if grpcSrv != nil {
+		grpcSrv.GracefulStop()
 	}
 }
@@ -0,0 +1,39 @@
+package router
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/Mockird31/avito_tech/config"
+	eventstream "github.com/Mockird31/avito_tech/internal/eventStream"
+	prRepository "github.com/Mockird31/avito_tech/internal/pullRequest/repository"
+	teamRepository "github.com/Mockird31/avito_tech/internal/team/repository"
+	userRepository "github.com/Mockird31/avito_tech/internal/user/repository"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
+
+	eventStreamBroker "github.com/Mockird31/avito_tech/internal/eventStream/broker"
+	eventStreamUsecase "github.com/Mockird31/avito_tech/internal/eventStream/usecase"
+
+	eventStreamDeliveryHttp "github.com/Mockird31/avito_tech/internal/eventStream/delivery/http"
+	eventStreamListener "github.com/Mockird31/avito_tech/internal/eventStream/listener"
+	"github.com/gorilla/mux"
+)
+
+// EventStream собирает usecase потока событий: одно LISTEN-соединение на экземпляр раздает события всем SSE-клиентам.
+func EventStream(postgresConn *sql.DB, cfg config.PostgresConfig) (eventstream.IUsecase, *eventStreamListener.Listener) {
+	eventStreamUse := eventStreamUsecase.NewUsecase(
+		prRepository.NewRepository(postgresConn),
+		userRepository.NewRepository(postgresConn),
+		teamRepository.NewRepository(postgresConn),
+		eventStreamBroker.NewBroker(),
+	)
+	return eventStreamUse, eventStreamListener.NewListener(eventStreamUse, postgres.DSN(cfg))
+}
+
+func EventStreamRouter(r *mux.Router, eventStreamUse eventstream.IUsecase) *mux.Router {
+	eventStreamHttp := eventStreamDeliveryHttp.NewHandler(eventStreamUse)
+
+	sr := r.PathPrefix("/events").Subrouter()
+	sr.HandleFunc("/stream", eventStreamHttp.Stream).Methods(http.MethodGet)
+	return sr
+}
@@ -0,0 +1,29 @@
+package router
+
+import (
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	"github.com/Mockird31/avito_tech/internal/stats"
+	"github.com/Mockird31/avito_tech/internal/team"
+	"github.com/Mockird31/avito_tech/internal/user"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	"github.com/Mockird31/avito_tech/internal/grpc/pb"
+	"github.com/Mockird31/avito_tech/internal/middleware"
+
+	prDeliveryGrpc "github.com/Mockird31/avito_tech/internal/pullRequest/delivery/grpc"
+	statsDeliveryGrpc "github.com/Mockird31/avito_tech/internal/stats/delivery/grpc"
+	teamDeliveryGrpc "github.com/Mockird31/avito_tech/internal/team/delivery/grpc"
+	userDeliveryGrpc "github.com/Mockird31/avito_tech/internal/user/delivery/grpc"
+)
+
+// GrpcServer регистрирует gRPC-сервисы поверх тех же usecase'ов, что обслуживают HTTP API.
+func GrpcServer(logger *zap.SugaredLogger, teamUse team.IUsecase, userUse user.IUsecase, prUse pullrequest.IUsecase, statsUse stats.IUsecase) *grpc.Server {
+	srv := grpc.NewServer(grpc.UnaryInterceptor(middleware.LoggerInterceptor(logger)))
+
+	pb.RegisterTeamServiceServer(srv, teamDeliveryGrpc.NewServer(teamUse))
+	pb.RegisterUserServiceServer(srv, userDeliveryGrpc.NewServer(userUse))
+	pb.RegisterPullRequestServiceServer(srv, prDeliveryGrpc.NewServer(prUse))
+	pb.RegisterStatsServiceServer(srv, statsDeliveryGrpc.NewServer(statsUse))
+	return srv
+}
@@ -0,0 +1,32 @@
+package router
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/Mockird31/avito_tech/config"
+	integrationRepository "github.com/Mockird31/avito_tech/internal/integration/repository"
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	userRepository "github.com/Mockird31/avito_tech/internal/user/repository"
+
+	integrationUsecase "github.com/Mockird31/avito_tech/internal/integration/usecase"
+
+	integrationDeliveryHttp "github.com/Mockird31/avito_tech/internal/integration/delivery/http"
+	"github.com/gorilla/mux"
+)
+
+func IntegrationRouter(r *mux.Router, postgresConn *sql.DB, prUse pullrequest.IUsecase, cfg config.IntegrationConfig) *mux.Router {
+	integrationRepo := integrationRepository.NewRepository(postgresConn)
+	userRepo := userRepository.NewRepository(postgresConn)
+
+	integrationUse := integrationUsecase.NewUsecase(integrationRepo, userRepo, prUse)
+
+	integrationHttp := integrationDeliveryHttp.NewHandler(integrationUse, cfg)
+
+	sr := r.PathPrefix("/integrations").Subrouter()
+	sr.HandleFunc("/github", integrationHttp.Github).Methods(http.MethodPost)
+	sr.HandleFunc("/gitlab", integrationHttp.Gitlab).Methods(http.MethodPost)
+	sr.HandleFunc("/userMappings/set", integrationHttp.SetUserMapping).Methods(http.MethodPost)
+	sr.HandleFunc("/userMappings/list", integrationHttp.ListUserMappings).Methods(http.MethodGet)
+	return sr
+}
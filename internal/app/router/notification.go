@@ -0,0 +1,62 @@
+package router
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/notification"
+	notificationRepository "github.com/Mockird31/avito_tech/internal/notification/repository"
+	prRepository "github.com/Mockird31/avito_tech/internal/pullRequest/repository"
+	userRepository "github.com/Mockird31/avito_tech/internal/user/repository"
+
+	notificationNotifier "github.com/Mockird31/avito_tech/internal/notification/notifier"
+	notificationUsecase "github.com/Mockird31/avito_tech/internal/notification/usecase"
+
+	notificationDeliveryHttp "github.com/Mockird31/avito_tech/internal/notification/delivery/http"
+	notificationWorker "github.com/Mockird31/avito_tech/internal/notification/delivery/worker"
+	"github.com/gorilla/mux"
+)
+
+func NotificationRouter(r *mux.Router, notificationUse notification.IUsecase) *mux.Router {
+	notificationHttp := notificationDeliveryHttp.NewHandler(notificationUse)
+
+	sr := r.PathPrefix("/notifications").Subrouter()
+	sr.HandleFunc("/settings/set", notificationHttp.SetSettings).Methods(http.MethodPost)
+	sr.HandleFunc("/digest/send", notificationHttp.SendDigests).Methods(http.MethodPost)
+	return sr
+}
+
+func DigestWorker(notificationUse notification.IUsecase, cfg config.NotificationConfig) (*notificationWorker.DigestScheduler, error) {
+	at, err := notificationWorker.ParseDigestAt(cfg.DigestAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid NOTIFY_DIGEST_AT %q: %w", cfg.DigestAt, err)
+	}
+	return notificationWorker.NewDigestScheduler(notificationUse, at), nil
+}
+
+// NotificationUsecase собирает usecase уведомлений с каналами из NOTIFY_NOTIFIERS.
+func NotificationUsecase(postgresConn *sql.DB, cfg config.NotificationConfig) (notification.IUsecase, error) {
+	notifiers := make([]notification.INotifier, 0, len(cfg.Notifiers))
+	for _, name := range cfg.Notifiers {
+		switch name {
+		case "chat":
+			if cfg.ChatWebhookUrl == "" {
+				return nil, fmt.Errorf("notifier %q requires NOTIFY_CHAT_WEBHOOK_URL", name)
+			}
+			notifiers = append(notifiers, notificationNotifier.NewChatNotifier(cfg))
+		case "log":
+			notifiers = append(notifiers, notificationNotifier.NewLogNotifier())
+		default:
+			return nil, fmt.Errorf("unknown notifier %q", name)
+		}
+	}
+
+	return notificationUsecase.NewUsecase(
+		notificationRepository.NewRepository(postgresConn),
+		userRepository.NewRepository(postgresConn),
+		prRepository.NewRepository(postgresConn),
+		notifiers...,
+	), nil
+}
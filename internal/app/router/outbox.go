@@ -0,0 +1,50 @@
+package router
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/Mockird31/avito_tech/config"
+	codehostGithub "github.com/Mockird31/avito_tech/internal/codehost/github"
+	codehostUsecase "github.com/Mockird31/avito_tech/internal/codehost/usecase"
+	integrationRepository "github.com/Mockird31/avito_tech/internal/integration/repository"
+	"github.com/Mockird31/avito_tech/internal/outbox"
+	outboxRepository "github.com/Mockird31/avito_tech/internal/outbox/repository"
+	pullRequestRepository "github.com/Mockird31/avito_tech/internal/pullRequest/repository"
+	webhookRepository "github.com/Mockird31/avito_tech/internal/webhook/repository"
+
+	outboxRelay "github.com/Mockird31/avito_tech/internal/outbox/relay"
+	outboxSink "github.com/Mockird31/avito_tech/internal/outbox/sink"
+	webhookDispatcher "github.com/Mockird31/avito_tech/internal/webhook/dispatcher"
+)
+
+// OutboxRelay собирает relay с sink'ами, перечисленными в OUTBOX_SINKS.
+func OutboxRelay(postgresConn *sql.DB, cfg *config.Config) (*outboxRelay.Relay, error) {
+	sinks := make([]outbox.ISink, 0, len(cfg.Outbox.Sinks))
+	for _, name := range cfg.Outbox.Sinks {
+		switch name {
+		case "webhook":
+			dispatcher := webhookDispatcher.NewDispatcher(webhookRepository.NewRepository(postgresConn), cfg.Webhook)
+			sinks = append(sinks, outboxSink.NewWebhookSink(dispatcher))
+		case "codehost":
+			usecase := codehostUsecase.NewUsecase(
+				pullRequestRepository.NewRepository(postgresConn),
+				integrationRepository.NewRepository(postgresConn),
+				codehostGithub.NewClient(cfg.CodeHost),
+			)
+			sinks = append(sinks, outboxSink.NewCodeHostSink(usecase))
+		case "notify":
+			usecase, err := NotificationUsecase(postgresConn, cfg.Notification)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, outboxSink.NewNotificationSink(usecase))
+		case "log":
+			sinks = append(sinks, outboxSink.NewLogSink())
+		default:
+			return nil, fmt.Errorf("unknown outbox sink %q", name)
+		}
+	}
+
+	return outboxRelay.NewRelay(outboxRepository.NewRepository(postgresConn), sinks, cfg.Outbox), nil
+}
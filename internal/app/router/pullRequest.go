@@ -4,9 +4,12 @@ import (
 	"database/sql"
 	"net/http"
 
+	outboxRepository "github.com/Mockird31/avito_tech/internal/outbox/repository"
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
 	prRepository "github.com/Mockird31/avito_tech/internal/pullRequest/repository"
 	teamRepository "github.com/Mockird31/avito_tech/internal/team/repository"
 	userRepository "github.com/Mockird31/avito_tech/internal/user/repository"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
 
 	prUsecase "github.com/Mockird31/avito_tech/internal/pullRequest/usecase"
 
@@ -14,18 +17,27 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func PullRequestRouter(r *mux.Router, postgresConn *sql.DB) *mux.Router {
+func PullRequestUsecase(postgresConn *sql.DB) pullrequest.IUsecase {
 	teamRepo := teamRepository.NewRepository(postgresConn)
 	userRepo := userRepository.NewRepository(postgresConn)
 	prRepo := prRepository.NewRepository(postgresConn)
+	outboxRepo := outboxRepository.NewRepository(postgresConn)
 
-	prUse := prUsecase.NewUsecase(prRepo, userRepo, teamRepo)
+	return prUsecase.NewUsecase(prRepo, userRepo, teamRepo, outboxRepo, postgres.NewTransactor(postgresConn))
+}
 
+func PullRequestRouter(r *mux.Router, prUse pullrequest.IUsecase) *mux.Router {
 	prHttp := prDeliveryHttp.NewHandler(prUse)
 
 	sr := r.PathPrefix("/pullRequest").Subrouter()
+	sr.HandleFunc("/get", prHttp.GetPullRequest).Methods(http.MethodGet)
+	sr.HandleFunc("/list", prHttp.ListPullRequests).Methods(http.MethodGet)
 	sr.HandleFunc("/create", prHttp.CreatePullRequest).Methods(http.MethodPost)
+	sr.HandleFunc("/update", prHttp.UpdatePullRequest).Methods(http.MethodPost)
 	sr.HandleFunc("/merge", prHttp.MergePullRequest).Methods(http.MethodPost)
 	sr.HandleFunc("/reassign", prHttp.ReassignPullRequest).Methods(http.MethodPost)
+	sr.HandleFunc("/reviewers/add", prHttp.AddReviewer).Methods(http.MethodPost)
+	sr.HandleFunc("/reviewers/remove", prHttp.RemoveReviewer).Methods(http.MethodPost)
+	sr.HandleFunc("/reconcile", prHttp.ReconcileReviewers).Methods(http.MethodPost)
 	return sr
 }
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/Mockird31/avito_tech/internal/stats"
 	statsRepository "github.com/Mockird31/avito_tech/internal/stats/repository"
 
 	statsUsecase "github.com/Mockird31/avito_tech/internal/stats/usecase"
@@ -12,14 +13,15 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func StatsRouter(r *mux.Router, postgresConn *sql.DB) *mux.Router {
-	statsRepo := statsRepository.NewRepository(postgresConn)
-
-	statsUse := statsUsecase.NewUsecase(statsRepo)
+func StatsUsecase(postgresConn *sql.DB) stats.IUsecase {
+	return statsUsecase.NewUsecase(statsRepository.NewRepository(postgresConn))
+}
 
+func StatsRouter(r *mux.Router, statsUse stats.IUsecase) *mux.Router {
 	statsHttp := statsDeliveryHttp.NewHandler(statsUse)
 
 	sr := r.PathPrefix("/stats").Subrouter()
 	sr.HandleFunc("/assignmentsByReviewers", statsHttp.GetAssignmentsStats).Methods(http.MethodGet)
+	sr.HandleFunc("/overdueReviews", statsHttp.GetOverdueReviews).Methods(http.MethodGet)
 	return sr
 }
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"net/http"
 
+	"github.com/Mockird31/avito_tech/internal/team"
 	teamRepository "github.com/Mockird31/avito_tech/internal/team/repository"
 	userRepository "github.com/Mockird31/avito_tech/internal/user/repository"
 
@@ -13,16 +14,21 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func TeamRouter(r *mux.Router, postgresConn *sql.DB) *mux.Router {
+func TeamUsecase(postgresConn *sql.DB) team.IUsecase {
 	teamRepo := teamRepository.NewRepository(postgresConn)
 	userRepo := userRepository.NewRepository(postgresConn)
 
-	teamUse := teamUsecase.NewUsecase(teamRepo, userRepo)
+	return teamUsecase.NewUsecase(teamRepo, userRepo)
+}
 
+func TeamRouter(r *mux.Router, teamUse team.IUsecase) *mux.Router {
 	teamHttp := teamDeliveryHttp.NewHandler(teamUse)
 
 	sr := r.PathPrefix("/team").Subrouter()
 	sr.HandleFunc("/add", teamHttp.AddTeam).Methods(http.MethodPost)
 	sr.HandleFunc("/get", teamHttp.GetTeam).Methods(http.MethodGet)
+	sr.HandleFunc("/setReviewCapacity", teamHttp.SetReviewCapacity).Methods(http.MethodPost)
+	sr.HandleFunc("/setReviewSla", teamHttp.SetReviewSla).Methods(http.MethodPost)
+	sr.HandleFunc("/setReviewerLimits", teamHttp.SetReviewerLimits).Methods(http.MethodPost)
 	return sr
 }
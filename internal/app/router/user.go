@@ -4,8 +4,12 @@ import (
 	"database/sql"
 	"net/http"
 
+	outboxRepository "github.com/Mockird31/avito_tech/internal/outbox/repository"
 	prRepository "github.com/Mockird31/avito_tech/internal/pullRequest/repository"
+	teamRepository "github.com/Mockird31/avito_tech/internal/team/repository"
+	"github.com/Mockird31/avito_tech/internal/user"
 	userRepository "github.com/Mockird31/avito_tech/internal/user/repository"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
 
 	userUsecase "github.com/Mockird31/avito_tech/internal/user/usecase"
 
@@ -13,17 +17,28 @@ import (
 	"github.com/gorilla/mux"
 )
 
-func UserRouter(r *mux.Router, postgresConn *sql.DB) *mux.Router {
+func UserUsecase(postgresConn *sql.DB) user.IUsecase {
 	userRepo := userRepository.NewRepository(postgresConn)
 	prRepo := prRepository.NewRepository(postgresConn)
+	teamRepo := teamRepository.NewRepository(postgresConn)
 
-	userUse := userUsecase.NewUsecase(userRepo, prRepo)
+	return userUsecase.NewUsecase(userRepo, prRepo, teamRepo, outboxRepository.NewRepository(postgresConn), postgres.NewTransactor(postgresConn))
+}
 
+func UserRouter(r *mux.Router, userUse user.IUsecase) *mux.Router {
 	userHttp := userDeliveryHttp.NewHandler(userUse)
 
 	sr := r.PathPrefix("/users").Subrouter()
+	sr.HandleFunc("/create", userHttp.CreateUser).Methods(http.MethodPost)
+	sr.HandleFunc("/update", userHttp.UpdateUser).Methods(http.MethodPost)
+	sr.HandleFunc("/delete", userHttp.DeleteUser).Methods(http.MethodPost)
+	sr.HandleFunc("/get", userHttp.GetUser).Methods(http.MethodGet)
+	sr.HandleFunc("/list", userHttp.ListUsers).Methods(http.MethodGet)
 	sr.HandleFunc("/setIsActive", userHttp.SetUserIsActive).Methods(http.MethodPost)
 	sr.HandleFunc("/getReview", userHttp.GetUserReviews).Methods(http.MethodGet)
+	sr.HandleFunc("/getAuthored", userHttp.GetUserAuthored).Methods(http.MethodGet)
 	sr.HandleFunc("/deactivate", userHttp.DeactivateTeamUsers).Methods(http.MethodPost)
+	sr.HandleFunc("/reactivate", userHttp.ReactivateTeamUsers).Methods(http.MethodPost)
+	sr.HandleFunc("/setReviewCapacity", userHttp.SetReviewCapacity).Methods(http.MethodPost)
 	return sr
 }
@@ -0,0 +1,60 @@
+package router
+
+import (
+	"net/http"
+
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	"github.com/Mockird31/avito_tech/internal/stats"
+	"github.com/Mockird31/avito_tech/internal/team"
+	"github.com/Mockird31/avito_tech/internal/user"
+
+	prDeliveryHttp "github.com/Mockird31/avito_tech/internal/pullRequest/delivery/http"
+	statsDeliveryHttp "github.com/Mockird31/avito_tech/internal/stats/delivery/http"
+	teamDeliveryHttp "github.com/Mockird31/avito_tech/internal/team/delivery/http"
+	userDeliveryHttp "github.com/Mockird31/avito_tech/internal/user/delivery/http"
+	"github.com/gorilla/mux"
+)
+
+// prIdPattern допускает "/" в идентификаторе: у pull request'ов из GitHub и GitLab он содержит путь репозитория.
+const prIdPattern = "{id:.+}"
+
+// V2Router - ресурсные маршруты /api/v2 поверх тех же usecase'ов, что и маршруты v1.
+func V2Router(r *mux.Router, teamUse team.IUsecase, userUse user.IUsecase, prUse pullrequest.IUsecase, statsUse stats.IUsecase) *mux.Router {
+	teamHttp := teamDeliveryHttp.NewHandler(teamUse)
+	userHttp := userDeliveryHttp.NewHandler(userUse)
+	prHttp := prDeliveryHttp.NewHandler(prUse)
+	statsHttp := statsDeliveryHttp.NewHandler(statsUse)
+
+	sr := r.PathPrefix("/api/v2").Subrouter()
+
+	sr.HandleFunc("/teams", teamHttp.CreateTeamV2).Methods(http.MethodPost)
+	sr.HandleFunc("/teams/{name}", teamHttp.GetTeamV2).Methods(http.MethodGet)
+	sr.HandleFunc("/teams/{name}/review-capacity", teamHttp.SetReviewCapacityV2).Methods(http.MethodPut)
+	sr.HandleFunc("/teams/{name}/review-sla", teamHttp.SetReviewSlaV2).Methods(http.MethodPut)
+	sr.HandleFunc("/teams/{name}/reviewer-limits", teamHttp.SetReviewerLimitsV2).Methods(http.MethodPut)
+	sr.HandleFunc("/teams/{name}/deactivate", userHttp.DeactivateTeamUsersV2).Methods(http.MethodPost)
+	sr.HandleFunc("/teams/{name}/reactivate", userHttp.ReactivateTeamUsersV2).Methods(http.MethodPost)
+
+	sr.HandleFunc("/users", userHttp.CreateUserV2).Methods(http.MethodPost)
+	sr.HandleFunc("/users", userHttp.ListUsersV2).Methods(http.MethodGet)
+	sr.HandleFunc("/users/{id}", userHttp.GetUserV2).Methods(http.MethodGet)
+	sr.HandleFunc("/users/{id}", userHttp.PatchUserV2).Methods(http.MethodPatch)
+	sr.HandleFunc("/users/{id}", userHttp.DeleteUserV2).Methods(http.MethodDelete)
+	sr.HandleFunc("/users/{id}/reviews", userHttp.GetUserReviewsV2).Methods(http.MethodGet)
+	sr.HandleFunc("/users/{id}/pull-requests", userHttp.GetUserAuthoredV2).Methods(http.MethodGet)
+	sr.HandleFunc("/users/{id}/review-capacity", userHttp.SetReviewCapacityV2).Methods(http.MethodPut)
+
+	sr.HandleFunc("/pull-requests", prHttp.CreatePullRequestV2).Methods(http.MethodPost)
+	sr.HandleFunc("/pull-requests", prHttp.ListPullRequestsV2).Methods(http.MethodGet)
+	sr.HandleFunc("/pull-requests/reconcile", prHttp.ReconcileReviewers).Methods(http.MethodPost)
+	sr.HandleFunc("/pull-requests/"+prIdPattern+"/merge", prHttp.MergePullRequestV2).Methods(http.MethodPost)
+	sr.HandleFunc("/pull-requests/"+prIdPattern+"/reassign", prHttp.ReassignPullRequestV2).Methods(http.MethodPost)
+	sr.HandleFunc("/pull-requests/"+prIdPattern+"/reviewers", prHttp.AddReviewerV2).Methods(http.MethodPost)
+	sr.HandleFunc("/pull-requests/"+prIdPattern+"/reviewers/{reviewer_id}", prHttp.RemoveReviewerV2).Methods(http.MethodDelete)
+	sr.HandleFunc("/pull-requests/"+prIdPattern, prHttp.GetPullRequestV2).Methods(http.MethodGet)
+	sr.HandleFunc("/pull-requests/"+prIdPattern, prHttp.PatchPullRequestV2).Methods(http.MethodPatch)
+
+	sr.HandleFunc("/stats/assignments", statsHttp.GetAssignmentsStatsV2).Methods(http.MethodGet)
+	sr.HandleFunc("/stats/overdue-reviews", statsHttp.GetOverdueReviewsV2).Methods(http.MethodGet)
+	return sr
+}
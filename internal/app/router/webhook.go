@@ -0,0 +1,28 @@
+package router
+
+import (
+	"database/sql"
+	"net/http"
+
+	webhookRepository "github.com/Mockird31/avito_tech/internal/webhook/repository"
+
+	webhookUsecase "github.com/Mockird31/avito_tech/internal/webhook/usecase"
+
+	webhookDeliveryHttp "github.com/Mockird31/avito_tech/internal/webhook/delivery/http"
+	"github.com/gorilla/mux"
+)
+
+func WebhookRouter(r *mux.Router, postgresConn *sql.DB) *mux.Router {
+	webhookRepo := webhookRepository.NewRepository(postgresConn)
+
+	webhookUse := webhookUsecase.NewUsecase(webhookRepo)
+
+	webhookHttp := webhookDeliveryHttp.NewHandler(webhookUse)
+
+	sr := r.PathPrefix("/webhooks").Subrouter()
+	sr.HandleFunc("/create", webhookHttp.CreateSubscription).Methods(http.MethodPost)
+	sr.HandleFunc("/list", webhookHttp.ListSubscriptions).Methods(http.MethodGet)
+	sr.HandleFunc("/delete", webhookHttp.DeleteSubscription).Methods(http.MethodPost)
+	sr.HandleFunc("/deliveries", webhookHttp.ListDeliveries).Methods(http.MethodGet)
+	return sr
+}
@@ -0,0 +1,17 @@
+package router
+
+import (
+	"time"
+
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+
+	prWorker "github.com/Mockird31/avito_tech/internal/pullRequest/delivery/worker"
+)
+
+func ReconcilerWorker(prUse pullrequest.IUsecase, interval time.Duration) *prWorker.Reconciler {
+	return prWorker.NewReconciler(prUse, interval)
+}
+
+func SlaWorker(prUse pullrequest.IUsecase, interval time.Duration) *prWorker.SlaScheduler {
+	return prWorker.NewSlaScheduler(prUse, interval)
+}
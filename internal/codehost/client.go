@@ -0,0 +1,41 @@
+package codehost
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// IClient назначает ревьюверов pull request'у во внешнем хостинге. logins - имена пользователей хостинга.
+type IClient interface {
+	Provider() string
+	RequestReviewers(ctx context.Context, ref *entity.ExternalPullRequestRef, logins []string) error
+	RemoveReviewers(ctx context.Context, ref *entity.ExternalPullRequestRef, logins []string) error
+}
+
+// APIError - хостинг ответил статусом не из 2xx.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("code host responded %d: %s", e.StatusCode, e.Message)
+}
+
+// Temporary сообщает, имеет ли смысл повторить запрос: ошибки 5xx и превышение лимита запросов.
+func (e *APIError) Temporary() bool {
+	return e.StatusCode >= http.StatusInternalServerError || e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsTemporary - ошибки сети и таймауты считаются временными, ответы 4xx, кроме 429, - нет.
+func IsTemporary(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Temporary()
+	}
+	return err != nil
+}
@@ -0,0 +1,60 @@
+package fake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// Call - запрос, полученный Client.
+type Call struct {
+	Method string
+	Ref    entity.ExternalPullRequestRef
+	Logins []string
+}
+
+// Client - IClient для тестов: записывает вызовы и возвращает ошибки из Errors по очереди.
+type Client struct {
+	provider string
+
+	mu     sync.Mutex
+	calls  []Call
+	Errors []error
+}
+
+func NewClient(provider string) *Client {
+	return &Client{provider: provider}
+}
+
+func (c *Client) Provider() string {
+	return c.provider
+}
+
+func (c *Client) RequestReviewers(ctx context.Context, ref *entity.ExternalPullRequestRef, logins []string) error {
+	return c.record("RequestReviewers", ref, logins)
+}
+
+func (c *Client) RemoveReviewers(ctx context.Context, ref *entity.ExternalPullRequestRef, logins []string) error {
+	return c.record("RemoveReviewers", ref, logins)
+}
+
+// Calls возвращает копию записанных вызовов.
+func (c *Client) Calls() []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Call(nil), c.calls...)
+}
+
+func (c *Client) record(method string, ref *entity.ExternalPullRequestRef, logins []string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.calls = append(c.calls, Call{Method: method, Ref: *ref, Logins: append([]string(nil), logins...)})
+	if len(c.Errors) == 0 {
+		return nil
+	}
+	err := c.Errors[0]
+	c.Errors = c.Errors[1:]
+	return err
+}
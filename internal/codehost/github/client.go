@@ -0,0 +1,98 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/codehost"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	acceptHeader = "application/vnd.github+json"
+	apiVersion   = "2022-11-28"
+)
+
+// Client - клиент REST API GitHub, работает от имени владельца токена.
+type Client struct {
+	client  *http.Client
+	baseUrl string
+	token   string
+}
+
+func NewClient(cfg config.CodeHostConfig) *Client {
+	return &Client{
+		client:  &http.Client{Timeout: cfg.Timeout},
+		baseUrl: strings.TrimRight(cfg.GithubApiUrl, "/"),
+		token:   cfg.GithubToken,
+	}
+}
+
+func (c *Client) Provider() string {
+	return entity.ProviderGithub
+}
+
+func (c *Client) RequestReviewers(ctx context.Context, ref *entity.ExternalPullRequestRef, logins []string) error {
+	return c.requestedReviewers(ctx, http.MethodPost, ref, logins)
+}
+
+func (c *Client) RemoveReviewers(ctx context.Context, ref *entity.ExternalPullRequestRef, logins []string) error {
+	return c.requestedReviewers(ctx, http.MethodDelete, ref, logins)
+}
+
+type reviewersRequest struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+func (c *Client) requestedReviewers(ctx context.Context, method string, ref *entity.ExternalPullRequestRef, logins []string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	body, err := json.Marshal(&reviewersRequest{Reviewers: logins})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/repos/%s/pulls/%d/requested_reviewers", c.baseUrl, ref.Repository, ref.Number)
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", acceptHeader)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Api-Version", apiVersion)
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		logger.Error("github request failed (requestedReviewers)", zap.Error(err), zap.String("method", method), zap.String("url", url))
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	var errResp errorResponse
+	message := strings.TrimSpace(string(respBody))
+	if json.Unmarshal(respBody, &errResp) == nil && errResp.Message != "" {
+		message = errResp.Message
+	}
+	logger.Error("github responded with error (requestedReviewers)", zap.Int("status_code", resp.StatusCode), zap.String("method", method), zap.String("url", url), zap.String("message", message))
+	return &codehost.APIError{StatusCode: resp.StatusCode, Message: message}
+}
@@ -0,0 +1,91 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/codehost"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func newTestClient(url string) *Client {
+	return NewClient(config.CodeHostConfig{GithubToken: "ghp_test", GithubApiUrl: url + "/", Timeout: time.Second})
+}
+
+var testRef = &entity.ExternalPullRequestRef{Provider: entity.ProviderGithub, Repository: "acme/api", Number: 42}
+
+func TestRequestReviewers_Success(t *testing.T) {
+	var gotMethod, gotPath, gotAuth string
+	var gotBody reviewersRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath, gotAuth = r.Method, r.URL.Path, r.Header.Get("Authorization")
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	err := newTestClient(server.URL).RequestReviewers(getTestContext(), testRef, []string{"hubot"})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, gotMethod)
+	assert.Equal(t, "/repos/acme/api/pulls/42/requested_reviewers", gotPath)
+	assert.Equal(t, "Bearer ghp_test", gotAuth)
+	assert.Equal(t, []string{"hubot"}, gotBody.Reviewers)
+}
+
+func TestRemoveReviewers_UsesDelete(t *testing.T) {
+	var gotMethod string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	err := newTestClient(server.URL).RemoveReviewers(getTestContext(), testRef, []string{"octocat"})
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodDelete, gotMethod)
+}
+
+func TestRequestReviewers_APIError(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		temporary bool
+	}{
+		{"unprocessable", http.StatusUnprocessableEntity, false},
+		{"rate limited", http.StatusTooManyRequests, true},
+		{"server error", http.StatusBadGateway, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"message":"Reviews may only be requested from collaborators."}`))
+			}))
+			defer server.Close()
+
+			err := newTestClient(server.URL).RequestReviewers(getTestContext(), testRef, []string{"hubot"})
+
+			var apiErr *codehost.APIError
+			require.ErrorAs(t, err, &apiErr)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+			assert.Equal(t, "Reviews may only be requested from collaborators.", apiErr.Message)
+			assert.Equal(t, tt.temporary, codehost.IsTemporary(err))
+		})
+	}
+}
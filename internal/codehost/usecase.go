@@ -0,0 +1,11 @@
+package codehost
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+type IUsecase interface {
+	SyncReviewers(ctx context.Context, event *entity.DomainEvent) error
+}
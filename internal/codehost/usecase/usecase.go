@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Mockird31/avito_tech/internal/codehost"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/integration"
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type usecase struct {
+	PRRepository          pullrequest.IRepository
+	IntegrationRepository integration.IRepository
+	Clients               map[string]codehost.IClient
+}
+
+func NewUsecase(PRRepository pullrequest.IRepository, IntegrationRepository integration.IRepository, Clients ...codehost.IClient) codehost.IUsecase {
+	clients := make(map[string]codehost.IClient, len(Clients))
+	for _, client := range Clients {
+		clients[client.Provider()] = client
+	}
+	return &usecase{
+		PRRepository:          PRRepository,
+		IntegrationRepository: IntegrationRepository,
+		Clients:               clients,
+	}
+}
+
+// SyncReviewers переносит назначение и снятие ревьювера на pull request во внешнем хостинге и записывает результат
+// для этого ревьювера; статус PR складывается из результатов всех его текущих ревьюверов.
+// Временная ошибка хостинга возвращается, чтобы outbox повторил событие; постоянная (нет сопоставления
+// пользователя, 4xx) только фиксируется в статусе синхронизации - повтор ее не исправит.
+func (u *usecase) SyncReviewers(ctx context.Context, event *entity.DomainEvent) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	switch event.Type {
+	case entity.EventTypeReviewerAssigned, entity.EventTypeReviewerReassigned, entity.EventTypeReviewerRemoved:
+	default:
+		return nil
+	}
+	ref, ok := entity.ParseExternalPullRequestId(event.PullRequestId)
+	if !ok {
+		return nil
+	}
+	client, ok := u.Clients[ref.Provider]
+	if !ok {
+		return nil
+	}
+	if event.Type == entity.EventTypeReviewerRemoved {
+		return u.removeReviewer(ctx, client, ref, event)
+	}
+
+	userIds := []string{event.ReviewerId}
+	if event.OldReviewerId != "" {
+		userIds = append(userIds, event.OldReviewerId)
+	}
+	logins, err := u.IntegrationRepository.GetExternalUsernamesByUserIds(ctx, ref.Provider, userIds)
+	if err != nil {
+		return err
+	}
+
+	login, ok := logins[event.ReviewerId]
+	if !ok {
+		logger.Info("reviewer is not mapped to code host user (SyncReviewers)", zap.String("pr_id", event.PullRequestId), zap.String("reviewer_id", event.ReviewerId))
+		return u.PRRepository.SetReviewerSyncStatus(ctx, event.PullRequestId, event.ReviewerId, entity.ReviewersSyncFailed,
+			fmt.Sprintf("reviewer %s is not mapped to a %s user", event.ReviewerId, ref.Provider))
+	}
+
+	err = client.RequestReviewers(ctx, ref, []string{login})
+	if err == nil && event.OldReviewerId != "" {
+		// Старого ревьювера без сопоставления на хостинге не запрашивали, снимать нечего.
+		if oldLogin, ok := logins[event.OldReviewerId]; ok {
+			err = client.RemoveReviewers(ctx, ref, []string{oldLogin})
+		}
+	}
+	if err != nil {
+		logger.Warn("failed to sync reviewers (SyncReviewers)", zap.Error(err), zap.String("pr_id", event.PullRequestId), zap.Bool("temporary", codehost.IsTemporary(err)))
+		if setErr := u.PRRepository.SetReviewerSyncStatus(ctx, event.PullRequestId, event.ReviewerId, entity.ReviewersSyncFailed, err.Error()); setErr != nil {
+			return setErr
+		}
+		if codehost.IsTemporary(err) {
+			return err
+		}
+		return nil
+	}
+
+	return u.PRRepository.SetReviewerSyncStatus(ctx, event.PullRequestId, event.ReviewerId, entity.ReviewersSyncSynced, "")
+}
+
+// removeReviewer снимает запрос ревью со снятого вручную ревьювера и убирает его результат синхронизации
+// из статуса PR. Постоянную ошибку хостинга повтор не исправит, поэтому она только логируется.
+func (u *usecase) removeReviewer(ctx context.Context, client codehost.IClient, ref *entity.ExternalPullRequestRef, event *entity.DomainEvent) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	logins, err := u.IntegrationRepository.GetExternalUsernamesByUserIds(ctx, ref.Provider, []string{event.ReviewerId})
+	if err != nil {
+		return err
+	}
+
+	// ревьювера без сопоставления на хостинге не запрашивали, снимать нечего
+	if login, ok := logins[event.ReviewerId]; ok {
+		if err := client.RemoveReviewers(ctx, ref, []string{login}); err != nil {
+			logger.Warn("failed to remove reviewer (SyncReviewers)", zap.Error(err), zap.String("pr_id", event.PullRequestId), zap.String("reviewer_id", event.ReviewerId), zap.Bool("temporary", codehost.IsTemporary(err)))
+			if codehost.IsTemporary(err) {
+				return err
+			}
+		}
+	}
+
+	return u.PRRepository.ClearReviewerSyncStatus(ctx, event.PullRequestId, event.ReviewerId)
+}
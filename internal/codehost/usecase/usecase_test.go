@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/codehost"
+	"github.com/Mockird31/avito_tech/internal/codehost/fake"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	mock_integration "github.com/Mockird31/avito_tech/mocks/integration"
+	mock_pullrequest "github.com/Mockird31/avito_tech/mocks/pullrequest"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const prId = "github:acme/api#42"
+
+var ref = entity.ExternalPullRequestRef{Provider: entity.ProviderGithub, Repository: "acme/api", Number: 42}
+
+func setupTest(t *testing.T) (codehost.IUsecase, *mock_pullrequest.MockIRepository, *mock_integration.MockIRepository, *fake.Client) {
+	prRepo := mock_pullrequest.NewMockIRepository(t)
+	integrationRepo := mock_integration.NewMockIRepository(t)
+	client := fake.NewClient(entity.ProviderGithub)
+	return NewUsecase(prRepo, integrationRepo, client), prRepo, integrationRepo, client
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestSyncReviewers_Assigned_RequestsReviewer(t *testing.T) {
+	uc, prRepo, integrationRepo, client := setupTest(t)
+	ctx := getTestContext()
+
+	integrationRepo.EXPECT().
+		GetExternalUsernamesByUserIds(mock.Anything, entity.ProviderGithub, []string{"u2"}).
+		Return(map[string]string{"u2": "hubot"}, nil)
+	prRepo.EXPECT().
+		SetReviewerSyncStatus(mock.Anything, prId, "u2", entity.ReviewersSyncSynced, "").
+		Return(nil)
+
+	err := uc.SyncReviewers(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: prId, ReviewerId: "u2"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []fake.Call{{Method: "RequestReviewers", Ref: ref, Logins: []string{"hubot"}}}, client.Calls())
+}
+
+func TestSyncReviewers_Reassigned_RequestsNewAndRemovesOld(t *testing.T) {
+	uc, prRepo, integrationRepo, client := setupTest(t)
+	ctx := getTestContext()
+
+	integrationRepo.EXPECT().
+		GetExternalUsernamesByUserIds(mock.Anything, entity.ProviderGithub, []string{"u3", "u2"}).
+		Return(map[string]string{"u2": "hubot", "u3": "octocat"}, nil)
+	prRepo.EXPECT().
+		SetReviewerSyncStatus(mock.Anything, prId, "u3", entity.ReviewersSyncSynced, "").
+		Return(nil)
+
+	err := uc.SyncReviewers(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerReassigned, PullRequestId: prId, ReviewerId: "u3", OldReviewerId: "u2"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []fake.Call{
+		{Method: "RequestReviewers", Ref: ref, Logins: []string{"octocat"}},
+		{Method: "RemoveReviewers", Ref: ref, Logins: []string{"hubot"}},
+	}, client.Calls())
+}
+
+func TestSyncReviewers_NotExternalPullRequest_Skipped(t *testing.T) {
+	uc, _, _, client := setupTest(t)
+	ctx := getTestContext()
+
+	err := uc.SyncReviewers(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: "pr-1001", ReviewerId: "u2"})
+	require.NoError(t, err)
+	assert.Empty(t, client.Calls())
+}
+
+func TestSyncReviewers_ReviewerNotMapped_MarksFailed(t *testing.T) {
+	uc, prRepo, integrationRepo, client := setupTest(t)
+	ctx := getTestContext()
+
+	integrationRepo.EXPECT().
+		GetExternalUsernamesByUserIds(mock.Anything, entity.ProviderGithub, []string{"u2"}).
+		Return(map[string]string{}, nil)
+	prRepo.EXPECT().
+		SetReviewerSyncStatus(mock.Anything, prId, "u2", entity.ReviewersSyncFailed, "reviewer u2 is not mapped to a github user").
+		Return(nil)
+
+	err := uc.SyncReviewers(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: prId, ReviewerId: "u2"})
+	require.NoError(t, err)
+	assert.Empty(t, client.Calls())
+}
+
+func TestSyncReviewers_PermanentError_MarksFailedWithoutRetry(t *testing.T) {
+	uc, prRepo, integrationRepo, client := setupTest(t)
+	ctx := getTestContext()
+
+	apiErr := &codehost.APIError{StatusCode: 422, Message: "not a collaborator"}
+	client.Errors = []error{apiErr}
+	integrationRepo.EXPECT().
+		GetExternalUsernamesByUserIds(mock.Anything, entity.ProviderGithub, []string{"u2"}).
+		Return(map[string]string{"u2": "hubot"}, nil)
+	prRepo.EXPECT().
+		SetReviewerSyncStatus(mock.Anything, prId, "u2", entity.ReviewersSyncFailed, apiErr.Error()).
+		Return(nil)
+
+	err := uc.SyncReviewers(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: prId, ReviewerId: "u2"})
+	require.NoError(t, err)
+}
+
+func TestSyncReviewers_TemporaryError_ReturnedForRetry(t *testing.T) {
+	uc, prRepo, integrationRepo, client := setupTest(t)
+	ctx := getTestContext()
+
+	netErr := errors.New("connection reset by peer")
+	client.Errors = []error{netErr}
+	integrationRepo.EXPECT().
+		GetExternalUsernamesByUserIds(mock.Anything, entity.ProviderGithub, []string{"u2"}).
+		Return(map[string]string{"u2": "hubot"}, nil)
+	prRepo.EXPECT().
+		SetReviewerSyncStatus(mock.Anything, prId, "u2", entity.ReviewersSyncFailed, netErr.Error()).
+		Return(nil)
+
+	err := uc.SyncReviewers(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: prId, ReviewerId: "u2"})
+	require.ErrorIs(t, err, netErr)
+}
+
+func TestSyncReviewers_Removed_RemovesReviewerAndClearsStatus(t *testing.T) {
+	uc, prRepo, integrationRepo, client := setupTest(t)
+	ctx := getTestContext()
+
+	integrationRepo.EXPECT().
+		GetExternalUsernamesByUserIds(mock.Anything, entity.ProviderGithub, []string{"u2"}).
+		Return(map[string]string{"u2": "hubot"}, nil)
+	prRepo.EXPECT().
+		ClearReviewerSyncStatus(mock.Anything, prId, "u2").
+		Return(nil)
+
+	err := uc.SyncReviewers(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerRemoved, PullRequestId: prId, ReviewerId: "u2"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []fake.Call{{Method: "RemoveReviewers", Ref: ref, Logins: []string{"hubot"}}}, client.Calls())
+}
+
+func TestSyncReviewers_Removed_TemporaryErrorReturnedForRetry(t *testing.T) {
+	uc, _, integrationRepo, client := setupTest(t)
+	ctx := getTestContext()
+
+	netErr := errors.New("connection reset by peer")
+	client.Errors = []error{netErr}
+	integrationRepo.EXPECT().
+		GetExternalUsernamesByUserIds(mock.Anything, entity.ProviderGithub, []string{"u2"}).
+		Return(map[string]string{"u2": "hubot"}, nil)
+
+	err := uc.SyncReviewers(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerRemoved, PullRequestId: prId, ReviewerId: "u2"})
+	require.ErrorIs(t, err, netErr)
+}
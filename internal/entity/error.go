@@ -3,13 +3,44 @@ package entity
 import "errors"
 
 var (
-	ErrTeamNameExist        = errors.New("team_name already exists")
-	ErrTeamNameNotFound     = errors.New("resource not found")
-	ErrTeamNoMembersByTeam  = errors.New("no members found by team name")
-	ErrUserNotFound         = errors.New("resource not found")
-	ErrPullRequestExist     = errors.New("PR id already exists")
-	ErrAuthorOrTeamNotExist = errors.New("resource not found")
-	ErrPullRequestNotExist  = errors.New("resource not found")
-	ErrRequestAlreadyMerged = errors.New("cannot reassign on merged PR")
-	ErrUsersNotSameTeam     = errors.New("users not in the same team")
+	ErrTeamNameExist         = errors.New("team_name already exists")
+	ErrTeamNameNotFound      = errors.New("resource not found")
+	ErrTeamNoMembersByTeam   = errors.New("no members found by team name")
+	ErrUserNotFound          = errors.New("resource not found")
+	ErrPullRequestExist      = errors.New("PR id already exists")
+	ErrAuthorOrTeamNotExist  = errors.New("resource not found")
+	ErrPullRequestNotExist   = errors.New("resource not found")
+	ErrRequestAlreadyMerged  = errors.New("cannot reassign on merged or closed PR")
+	ErrUsersNotSameTeam      = errors.New("users not in the same team")
+	ErrInvalidReviewCapacity = errors.New("max_open_reviews must be positive")
+	ErrInvalidReviewSla      = errors.New("review_sla_hours must be positive")
+	ErrInvalidReviewerLimits = errors.New("max_reviewers must be positive and min_reviewers must be 0..max_reviewers")
+	ErrWebhookNotFound       = errors.New("webhook subscription not found")
+	ErrInvalidWebhookEvent   = errors.New("unknown webhook event type")
+	ErrInvalidSignature      = errors.New("invalid webhook signature")
+	ErrExternalUserNotMapped = errors.New("external user is not mapped to user_id")
+	ErrDigestAlreadySent     = errors.New("digest for this day is already sent")
+	ErrUserExist             = errors.New("user_id already exists")
+	ErrUserDeleted           = errors.New("user_id belongs to a deleted user")
+	ErrNothingToUpdate       = errors.New("nothing to update")
+	ErrInvalidPagination     = errors.New("invalid pagination parameters")
+	ErrInvalidFilter         = errors.New("invalid filter parameters")
+	ErrVersionConflict       = errors.New("pull request was modified, version mismatch")
+	ErrInvalidPriority       = errors.New("priority must be one of LOW, MEDIUM, HIGH")
+	ErrInvalidLabels         = errors.New("labels must be 1..64 characters, at most 20")
+	ErrEmptyPullRequestName  = errors.New("pull_request_name must not be empty")
+
+	ErrPullRequestMerged       = errors.New("cannot change reviewers on merged or closed PR")
+	ErrPullRequestNotOpen      = errors.New("PR is not open")
+	ErrPullRequestNotClosed    = errors.New("PR is not closed")
+	ErrReviewerNotFound        = errors.New("reviewer not found")
+	ErrReviewerIsAuthor        = errors.New("author cannot review own PR")
+	ErrReviewerInactive        = errors.New("reviewer is not active")
+	ErrReviewerNotInTeam       = errors.New("reviewer is not in the author's team")
+	ErrReviewerAlreadyAssigned = errors.New("reviewer already assigned to this PR")
+	ErrReviewerAtCapacity      = errors.New("reviewer is at review capacity")
+	ErrTooManyReviewers        = errors.New("PR already has the maximum number of reviewers")
+	ErrTooFewReviewers         = errors.New("PR cannot have fewer reviewers than the team minimum")
+	ErrReviewerNotAssigned     = errors.New("reviewer is not assigned to this PR")
+	ErrReviewersChanged        = errors.New("PR reviewers were changed concurrently, retry the request")
 )
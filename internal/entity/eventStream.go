@@ -0,0 +1,34 @@
+package entity
+
+import "time"
+
+// StreamEvent - доменное событие, дополненное данными pull request'а для фильтрации и отображения на дашборде.
+type StreamEvent struct {
+	Id              string    `json:"id"`
+	Type            string    `json:"type"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorId        string    `json:"author_id"`
+	TeamName        string    `json:"team_name"`
+	ReviewerId      string    `json:"reviewer_id,omitempty"`
+	OldReviewerId   string    `json:"old_reviewer_id,omitempty"`
+	OccurredAt      time.Time `json:"occurred_at"`
+}
+
+// StreamFilter - какие события нужны подписчику. Пустые поля не ограничивают выдачу.
+type StreamFilter struct {
+	TeamName string
+	UserId   string
+}
+
+// Match - событие относится к команде автора pull request'а и затрагивает пользователя
+// как автора, назначенного или снятого ревьювера.
+func (f *StreamFilter) Match(event *StreamEvent) bool {
+	if f.TeamName != "" && f.TeamName != event.TeamName {
+		return false
+	}
+	if f.UserId != "" && f.UserId != event.AuthorId && f.UserId != event.ReviewerId && f.UserId != event.OldReviewerId {
+		return false
+	}
+	return true
+}
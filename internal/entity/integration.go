@@ -0,0 +1,96 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Хостинги кода, от которых принимаются вебхуки pull request'ов.
+const (
+	ProviderGithub = "github"
+	ProviderGitlab = "gitlab"
+)
+
+// Действия с pull request'ом во внешнем хостинге, к которым приводятся события GitHub и GitLab.
+const (
+	ExternalActionOpened   = "opened"
+	ExternalActionReopened = "reopened"
+	ExternalActionClosed   = "closed"
+	ExternalActionMerged   = "merged"
+)
+
+const (
+	IntegrationOutcomeCreated  = "created"
+	IntegrationOutcomeMerged   = "merged"
+	IntegrationOutcomeClosed   = "closed"
+	IntegrationOutcomeReopened = "reopened"
+	IntegrationOutcomeIgnored  = "ignored"
+)
+
+// ExternalPullRequestEvent - событие pull request'а из GitHub или GitLab, приведенное к общему виду.
+// GitHub передает имя автора, GitLab - только его числовой идентификатор.
+type ExternalPullRequestEvent struct {
+	Provider       string
+	Action         string
+	PullRequestId  string
+	Title          string
+	AuthorUsername string
+	AuthorId       int64
+}
+
+// ExternalPullRequestRef - pull request во внешнем хостинге, из которого создан pull request сервиса.
+type ExternalPullRequestRef struct {
+	Provider   string
+	Repository string
+	Number     int
+}
+
+// externalNumberSeparators - как хостинги обозначают номер pull request'а: acme/api#42 и acme/api!42.
+var externalNumberSeparators = map[string]string{
+	ProviderGithub: "#",
+	ProviderGitlab: "!",
+}
+
+// PullRequestId - идентификатор pull request'а в сервисе: "github:<owner>/<repo>#<номер>" или "gitlab:<group>/<project>!<iid>".
+func (r *ExternalPullRequestRef) PullRequestId() string {
+	return fmt.Sprintf("%s:%s%s%d", r.Provider, r.Repository, externalNumberSeparators[r.Provider], r.Number)
+}
+
+// ParseExternalPullRequestId разбирает идентификатор, построенный PullRequestId. Для pull request'ов,
+// созданных напрямую через /pullRequest/create, возвращает false.
+func ParseExternalPullRequestId(prId string) (*ExternalPullRequestRef, bool) {
+	provider, rest, ok := strings.Cut(prId, ":")
+	if !ok {
+		return nil, false
+	}
+	separator, ok := externalNumberSeparators[provider]
+	if !ok {
+		return nil, false
+	}
+
+	i := strings.LastIndex(rest, separator)
+	if i <= 0 {
+		return nil, false
+	}
+	number, err := strconv.Atoi(rest[i+1:])
+	if err != nil || number <= 0 {
+		return nil, false
+	}
+
+	return &ExternalPullRequestRef{Provider: provider, Repository: rest[:i], Number: number}, true
+}
+
+// IntegrationResult - что сделано по входящему событию.
+type IntegrationResult struct {
+	PullRequestId string `json:"pull_request_id,omitempty"`
+	Outcome       string `json:"outcome"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+type ExternalUserMapping struct {
+	Provider         string `json:"provider" valid:"required~provider is required,in(github|gitlab)~provider must be github or gitlab"`
+	ExternalUsername string `json:"external_username" valid:"required~external_username is required"`
+	ExternalUserId   int64  `json:"external_user_id,omitempty"`
+	UserId           string `json:"user_id" valid:"required~user_id is required"`
+}
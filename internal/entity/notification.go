@@ -0,0 +1,29 @@
+package entity
+
+// Поводы для уведомления ревьювера.
+const (
+	NotificationKindAssigned       = "assigned"
+	NotificationKindReassignedAway = "reassigned_away"
+	NotificationKindDigest         = "digest"
+)
+
+// Notification - сообщение одному пользователю.
+type Notification struct {
+	Kind          string
+	UserId        string
+	Username      string
+	PullRequestId string
+	Text          string
+}
+
+type NotificationSettings struct {
+	UserId  string `json:"user_id" valid:"required~user_id is required,stringlength(1|64)~user_id length 1..64"`
+	Enabled bool   `json:"enabled"`
+}
+
+// DigestResult - итог рассылки дайджеста открытых ревью.
+type DigestResult struct {
+	Recipients int `json:"recipients"`
+	Sent       int `json:"sent"`
+	Failed     int `json:"failed"`
+}
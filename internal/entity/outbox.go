@@ -0,0 +1,14 @@
+package entity
+
+// DomainEventsChannel - канал Postgres NOTIFY, в который outbox дублирует каждое записанное событие
+// с idempotency_key. Уведомление приходит слушателям только после коммита транзакции.
+const DomainEventsChannel = "domain_events"
+
+// OutboxMessage - событие из outbox, взятое relay'ем на публикацию.
+// DeliveredSinks - имена sink'ов, которые уже приняли событие на прошлых попытках.
+type OutboxMessage struct {
+	Id             int64
+	Event          *DomainEvent
+	Attempts       int
+	DeliveredSinks []string
+}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 100
+
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// PullRequestCursor - позиция последнего отданного pull request'а при постраничной выдаче,
+// выдача упорядочена по (created_at, id).
+type PullRequestCursor struct {
+	CreatedAt time.Time
+	Id        string
+}
+
+func (c *PullRequestCursor) Encode() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.Id
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodePullRequestCursor(cursor string) (*PullRequestCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidPagination
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, ErrInvalidPagination
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, ErrInvalidPagination
+	}
+
+	return &PullRequestCursor{CreatedAt: t, Id: id}, nil
+}
+
+// PullRequestFilter - фильтры, сортировка и пагинация списков pull request'ов.
+type PullRequestFilter struct {
+	ReviewerId  string
+	AuthorId    string
+	TeamName    string
+	NameSearch  string
+	Status      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	MergedFrom  *time.Time
+	MergedTo    *time.Time
+	Order       string
+	Cursor      *PullRequestCursor
+	Limit       int
+}
+
+// Normalize проверяет фильтр и проставляет значения по умолчанию.
+func (f *PullRequestFilter) Normalize() error {
+	if f.Status != "" && f.Status != StatusOpen.String() && f.Status != StatusMerged.String() && f.Status != StatusClosed.String() {
+		return ErrInvalidFilter
+	}
+
+	switch f.Order {
+	case "":
+		f.Order = SortOrderDesc
+	case SortOrderAsc, SortOrderDesc:
+	default:
+		return ErrInvalidFilter
+	}
+
+	if f.Limit < 0 || f.Limit > MaxPageLimit {
+		return ErrInvalidPagination
+	}
+
+	if f.Limit == 0 {
+		f.Limit = DefaultPageLimit
+	}
+	return nil
+}
+
+// PagePullRequests обрезает выборку, запрошенную с запасом в одну строку, до limit
+// и возвращает курсор следующей страницы, если она есть.
+func PagePullRequests(pullRequests []*PullRequestShort, limit int) ([]*PullRequestShort, string) {
+	if len(pullRequests) <= limit {
+		return pullRequests, ""
+	}
+
+	pullRequests = pullRequests[:limit]
+	last := pullRequests[len(pullRequests)-1]
+	cursor := &PullRequestCursor{CreatedAt: last.CreatedAt, Id: last.Id}
+	return pullRequests, cursor.Encode()
+}
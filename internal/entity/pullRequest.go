@@ -2,11 +2,67 @@ package entity
 
 import "time"
 
+// RequiredReviewersCount - сколько ревьюверов назначается на новый pull request.
+const RequiredReviewersCount = 2
+
+// Лимиты ревьюверов команды по умолчанию, совпадают со значениями по умолчанию в таблице team.
+const (
+	DefaultMinReviewersCount = 1
+	DefaultMaxReviewersCount = 5
+)
+
+const (
+	EventReviewerAdded   = "reviewer_added"
+	EventReviewerRemoved = "reviewer_removed"
+	// EventReviewerAutoAdded записывается, когда ревьювера добавила фоновая сверка.
+	EventReviewerAutoAdded = "reviewer_auto_added"
+)
+
+const (
+	ShortfallReasonNoCandidates = "not enough active team members"
+	ShortfallReasonAtCapacity   = "reviewers at capacity"
+)
+
+const (
+	ReassignmentReassigned  = "reassigned"
+	ReassignmentNoCandidate = "no candidate"
+
+	ReassignmentReasonReplaced        = "replaced by the least loaded active team member"
+	ReassignmentReasonNoActiveMembers = "no active team member left besides deactivated users"
+	ReassignmentReasonOnlyAuthor      = "the only active team member left is the PR author"
+	ReassignmentReasonAllAssigned     = "every active team member besides the author already reviews this PR"
+	ReassignmentReasonAtCapacity      = "every eligible team member is at review capacity"
+)
+
+// Состояние ревью у назначенного ревьювера. Отдельного подтверждения ревью в сервисе нет,
+// поэтому состояние выводится из статуса pull request'а и активности ревьювера.
+const (
+	ReviewStatePending          = "pending"
+	ReviewStateReviewerInactive = "reviewer inactive"
+	ReviewStateCompleted        = "completed"
+)
+
+const (
+	PriorityLow    = "LOW"
+	PriorityMedium = "MEDIUM"
+	PriorityHigh   = "HIGH"
+
+	DefaultPriority = PriorityMedium
+
+	MaxLabelsCount = 20
+	MaxLabelLength = 64
+
+	// Ограничения из тегов valid у PullRequest, нужны там, где pull request создается не из запроса API.
+	MaxPullRequestIdLength   = 64
+	MaxPullRequestNameLength = 256
+)
+
 type StatusPr string
 
 const (
 	StatusOpen   StatusPr = "OPEN"
 	StatusMerged StatusPr = "MERGED"
+	StatusClosed StatusPr = "CLOSED"
 )
 
 func (sp StatusPr) String() string {
@@ -15,33 +71,171 @@ func (sp StatusPr) String() string {
 		return "OPEN"
 	case StatusMerged:
 		return "MERGED"
+	case StatusClosed:
+		return "CLOSED"
 	}
 	return ""
 }
 
 type PullRequest struct {
-	Id                   string     `json:"pull_request_id" valid:"stringlength(1|64)~id length 1..64"`
-	PrName               string     `json:"pull_request_name" valid:"stringlength(1|256)~name length 1..256"`
-	AuthorId             string     `json:"author_id" valid:"stringlength(1|64)~author_id length 1..64"`
-	Status               string     `json:"status" valid:"in(OPEN|MERGED)~invalid status"`
-	AssignedReviewersIds []string   `json:"assigned_reviewers"`
-	MergedAt             *time.Time `json:"mergedAt,omitempty"`
+	Id                   string             `json:"pull_request_id" valid:"stringlength(1|64)~id length 1..64"`
+	PrName               string             `json:"pull_request_name" valid:"stringlength(1|256)~name length 1..256"`
+	AuthorId             string             `json:"author_id" valid:"stringlength(1|64)~author_id length 1..64"`
+	Status               string             `json:"status" valid:"in(OPEN|MERGED|CLOSED)~invalid status"`
+	AssignedReviewersIds []string           `json:"assigned_reviewers"`
+	Description          string             `json:"description"`
+	Labels               []string           `json:"labels"`
+	Priority             string             `json:"priority"`
+	Version              int                `json:"version"`
+	MergedAt             *time.Time         `json:"mergedAt,omitempty"`
+	ReviewerShortfall    *ReviewerShortfall `json:"reviewer_shortfall,omitempty"`
+	ReviewersSync        *ReviewersSync     `json:"reviewers_sync,omitempty"`
+}
+
+const (
+	ReviewersSyncSynced = "synced"
+	ReviewersSyncFailed = "failed"
+)
+
+// ReviewersSync - состояние синхронизации текущих ревьюверов pull request'а с внешним хостингом.
+type ReviewersSync struct {
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PullRequestUpdate - изменение метаданных pull request'а, непереданные поля не меняются.
+// Version должна совпадать с текущей версией pull request'а.
+type PullRequestUpdate struct {
+	Id          string   `json:"pull_request_id" valid:"required,stringlength(1|64)~pull_request_id length 1..64"`
+	Version     int      `json:"version" valid:"required~version is required"`
+	PrName      *string  `json:"pull_request_name" valid:"stringlength(1|256)~name length 1..256"`
+	Description *string  `json:"description" valid:"stringlength(0|4096)~description length 0..4096"`
+	Labels      []string `json:"labels"`
+	Priority    *string  `json:"priority"`
+}
+
+type ReviewerShortfall struct {
+	Required   int    `json:"required"`
+	Assigned   int    `json:"assigned"`
+	AtCapacity int    `json:"at_capacity"`
+	Reason     string `json:"reason"`
+}
+
+// PullRequestReassignResult - итог /pullRequest/reassign. Если замены не нашлось, Outcome равен
+// ReassignmentNoCandidate, а ExcludedCandidates объясняет, почему не подошел ни один участник команды автора.
+type PullRequestReassignResult struct {
+	PullRequest        *PullRequest
+	ReplacedBy         string
+	Outcome            string
+	ExcludedCandidates *CandidateExclusions
+}
+
+// CandidateExclusions - число участников команды автора, отсеянных по каждой причине.
+// Каждый участник учитывается один раз, по первой подходящей причине в порядке полей.
+type CandidateExclusions struct {
+	Author          int `json:"author"`
+	Inactive        int `json:"inactive"`
+	AlreadyAssigned int `json:"already_assigned"`
+	AtCapacity      int `json:"at_capacity"`
 }
 
 type PullRequestShort struct {
-	Id       string     `json:"pull_request_id"`
-	PrName   string     `json:"pull_request_name"`
-	AuthorId string     `json:"author_id"`
-	Status   string     `json:"status"`
-	MergedAt *time.Time `json:"mergedAt,omitempty"`
+	Id        string     `json:"pull_request_id"`
+	PrName    string     `json:"pull_request_name"`
+	AuthorId  string     `json:"author_id"`
+	Status    string     `json:"status"`
+	CreatedAt time.Time  `json:"createdAt"`
+	MergedAt  *time.Time `json:"mergedAt,omitempty"`
 }
 
 type PullRequestReassignRequest struct {
-    Id            string `json:"pull_request_id" valid:"stringlength(1|64)~pull_request_id length 1..64"`
-    OldReviewerId string `json:"old_reviewer_id" valid:"stringlength(1|64)~old_reviewer_id length 1..64"`
+	Id            string `json:"pull_request_id" valid:"stringlength(1|64)~pull_request_id length 1..64"`
+	OldReviewerId string `json:"old_reviewer_id" valid:"stringlength(1|64)~old_reviewer_id length 1..64"`
+	// NewReviewerId - необязательный конкретный ревьювер вместо случайного выбора
+	NewReviewerId string `json:"new_reviewer_id,omitempty" valid:"stringlength(1|64)~new_reviewer_id length 1..64"`
 }
 
 type ReviewerPullRequests struct {
 	UserId       string              `json:"user_id"`
 	PullRequests []*PullRequestShort `json:"pull_requests"`
+	Total        int                 `json:"total"`
+	NextCursor   string              `json:"next_cursor,omitempty"`
+}
+
+type PullRequestReviewer struct {
+	UserId     string    `json:"user_id"`
+	Username   string    `json:"username"`
+	IsActive   bool      `json:"is_active"`
+	AssignedAt time.Time `json:"assignedAt"`
+	State      string    `json:"state"`
+}
+
+type AuthoredPullRequest struct {
+	*PullRequestShort
+	Reviewers []*PullRequestReviewer `json:"reviewers"`
+}
+
+type AuthorPullRequests struct {
+	UserId       string                 `json:"user_id"`
+	PullRequests []*AuthoredPullRequest `json:"pull_requests"`
+	Total        int                    `json:"total"`
+	NextCursor   string                 `json:"next_cursor,omitempty"`
+}
+
+type PullRequestList struct {
+	PullRequests []*PullRequestShort `json:"pull_requests"`
+	Total        int                 `json:"total"`
+	NextCursor   string              `json:"next_cursor,omitempty"`
+}
+
+type PullRequestReviewerChange struct {
+	Id         string `json:"pull_request_id" valid:"required,stringlength(1|64)~pull_request_id length 1..64"`
+	ReviewerId string `json:"reviewer_id" valid:"required,stringlength(1|64)~reviewer_id length 1..64"`
+}
+
+type PullRequestEvent struct {
+	PullRequestId string
+	EventType     string
+	ReviewerId    string
+}
+
+type ReviewAssignment struct {
+	PullRequestId string `json:"pull_request_id"`
+	AuthorId      string `json:"author_id"`
+	ReviewerId    string `json:"reviewer_id"`
+	TeamName      string `json:"team_name"`
+}
+
+// UnderReviewedPullRequest - открытый pull request, на котором меньше RequiredReviewersCount ревьюверов.
+type UnderReviewedPullRequest struct {
+	PullRequestId  string
+	AuthorId       string
+	ReviewersCount int
+}
+
+type ReconcileTopUp struct {
+	PullRequestId    string   `json:"pull_request_id"`
+	AddedReviewerIds []string `json:"added_reviewer_ids"`
+}
+
+// ReconcileResult - отчет одного прохода сверки: сколько pull request'ов проверено и кого куда добавили.
+type ReconcileResult struct {
+	Checked  int               `json:"checked"`
+	Added    int               `json:"added_reviewers"`
+	ToppedUp []*ReconcileTopUp `json:"topped_up"`
+}
+
+type ReviewerMove struct {
+	PullRequestId  string `json:"pull_request_id"`
+	FromReviewerId string `json:"from_reviewer_id"`
+	ToReviewerId   string `json:"to_reviewer_id"`
+}
+
+type ReviewerReassignment struct {
+	PullRequestId string  `json:"pull_request_id"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	NewReviewerId *string `json:"new_reviewer_id"`
+	Result        string  `json:"result"`
+	Reason        string  `json:"reason"`
 }
@@ -21,9 +21,19 @@ type PullRequestResponse struct {
 	PullRequest *PullRequest `json:"pr"`
 }
 
+type PullRequestListResponse struct {
+	PullRequestList *PullRequestList `json:"pull_request_list"`
+}
+
+type ReconcileResponse struct {
+	Reconcile *ReconcileResult `json:"reconcile"`
+}
+
 type PullRequestReassignResponse struct {
-	PullRequest *PullRequest `json:"pr"`
-	ReplacedBy  string       `json:"replaced_by"`
+	PullRequest        *PullRequest         `json:"pr"`
+	ReplacedBy         string               `json:"replaced_by"`
+	Outcome            string               `json:"outcome"`
+	ExcludedCandidates *CandidateExclusions `json:"excluded_candidates,omitempty"`
 }
 
 type AssignmentStatsResponse struct {
@@ -33,3 +43,67 @@ type AssignmentStatsResponse struct {
 type DeactivateUsersResponse struct {
 	DeactivateUsers *DeactivateUsers `json:"deactivate_users"`
 }
+
+type ReactivateUsersResponse struct {
+	ReactivateUsers *ReactivateUsersResult `json:"reactivate_users"`
+}
+
+type WebhookResponse struct {
+	Webhook *WebhookSubscription `json:"webhook"`
+}
+
+type WebhookListResponse struct {
+	Webhooks []*WebhookSubscription `json:"webhooks"`
+}
+
+type WebhookDeliveriesResponse struct {
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+}
+
+type TeamReviewSlaResponse struct {
+	Sla *TeamReviewSla `json:"review_sla"`
+}
+
+type OverdueReviewsResponse struct {
+	OverdueReviews []*OverdueReview `json:"overdue_reviews"`
+}
+
+type TeamReviewerLimitsResponse struct {
+	Limits *TeamReviewerLimits `json:"reviewer_limits"`
+}
+
+type TeamReviewCapacityResponse struct {
+	Capacity *TeamReviewCapacity `json:"capacity"`
+}
+
+type UserReviewCapacityResponse struct {
+	Capacity *UserReviewCapacity `json:"capacity"`
+}
+
+type UserListResponse struct {
+	UserList *UserList `json:"user_list"`
+}
+
+type UserDeleteResponse struct {
+	DeletedUser *UserDeleteResult `json:"deleted_user"`
+}
+
+type IntegrationResponse struct {
+	Result *IntegrationResult `json:"result"`
+}
+
+type UserMappingResponse struct {
+	Mapping *ExternalUserMapping `json:"mapping"`
+}
+
+type UserMappingListResponse struct {
+	Mappings []*ExternalUserMapping `json:"mappings"`
+}
+
+type NotificationSettingsResponse struct {
+	Settings *NotificationSettings `json:"notification_settings"`
+}
+
+type DigestResponse struct {
+	Digest *DigestResult `json:"digest"`
+}
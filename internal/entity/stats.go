@@ -1,6 +1,25 @@
 package entity
 
+import "time"
+
 type UserAssignmentCount struct {
 	UserId string `json:"user_id"`
 	Count  int    `json:"count"`
 }
+
+// OverdueReview - назначение ревьювера, не закрытое за SLA команды автора.
+type OverdueReview struct {
+	PullRequestId  string    `json:"pull_request_id"`
+	ReviewerId     string    `json:"reviewer_id"`
+	TeamName       string    `json:"team_name"`
+	AssignedAt     time.Time `json:"assigned_at"`
+	OverdueAt      time.Time `json:"overdue_at"`
+	ReviewSlaHours int       `json:"review_sla_hours"`
+	AutoReassign   bool      `json:"-"`
+}
+
+// OverdueSweepResult - итог одного прохода проверки SLA.
+type OverdueSweepResult struct {
+	Marked     int
+	Reassigned int
+}
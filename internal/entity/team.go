@@ -10,3 +10,37 @@ type Team struct {
 	TeamName string        `json:"team_name" valid:"stringlength(1|128)~team_name length 1..128"`
 	Members  []*TeamMember `json:"members"`
 }
+
+// TeamReviewSla - SLA ревью команды: ReviewSlaHours = nil снимает SLA, AutoReassign переназначает просроченные ревью.
+type TeamReviewSla struct {
+	TeamName       string `json:"team_name" valid:"stringlength(1|128)~team_name length 1..128"`
+	ReviewSlaHours *int   `json:"review_sla_hours"`
+	AutoReassign   bool   `json:"auto_reassign"`
+	MinReviewers   int    `json:"min_reviewers"`
+	MaxReviewers   int    `json:"max_reviewers"`
+}
+
+type TeamReviewCapacity struct {
+	TeamName       string `json:"team_name" valid:"stringlength(1|128)~team_name length 1..128"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+}
+
+// TeamReviewerLimits - сколько ревьюверов может быть на pull request'е автора из команды:
+// вручную нельзя добавить ревьювера сверх MaxReviewers и снять ревьювера ниже MinReviewers.
+type TeamReviewerLimits struct {
+	TeamName     string `json:"team_name" valid:"stringlength(1|128)~team_name length 1..128"`
+	MinReviewers int    `json:"min_reviewers"`
+	MaxReviewers int    `json:"max_reviewers"`
+}
+
+// TeamSummary - строка списка команд: число участников без удаленных пользователей и настройки ревью.
+type TeamSummary struct {
+	TeamName       string `json:"team_name"`
+	Members        int    `json:"members"`
+	ActiveMembers  int    `json:"active_members"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	ReviewSlaHours *int   `json:"review_sla_hours"`
+	AutoReassign   bool   `json:"auto_reassign"`
+	MinReviewers   int    `json:"min_reviewers"`
+	MaxReviewers   int    `json:"max_reviewers"`
+}
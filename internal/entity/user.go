@@ -5,6 +5,11 @@ type UserUpdateActive struct {
 	IsActive bool   `json:"is_active"`
 }
 
+type UserReviewCapacity struct {
+	UserId         string `json:"user_id" valid:"stringlength(1|64)~user_id length 1..64"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+}
+
 type User struct {
 	UserId   string `json:"user_id"`
 	Username string `json:"username"`
@@ -12,7 +17,87 @@ type User struct {
 	IsActive bool   `json:"is_active"`
 }
 
+type UserCreate struct {
+	UserId   string `json:"user_id" valid:"required,stringlength(1|64)~user_id length 1..64"`
+	Username string `json:"username" valid:"required,stringlength(1|128)~username length 1..128"`
+	TeamName string `json:"team_name" valid:"required,stringlength(1|128)~team_name length 1..128"`
+	IsActive bool   `json:"is_active"`
+}
+
+type UserUpdate struct {
+	UserId   string  `json:"user_id" valid:"stringlength(1|64)~user_id length 1..64"`
+	Username *string `json:"username"`
+	TeamName *string `json:"team_name"`
+}
+
+// UserPatch - тело PATCH /api/v2/users/{id}, непереданные поля не меняются.
+type UserPatch struct {
+	UserId   string  `json:"-"`
+	Username *string `json:"username"`
+	TeamName *string `json:"team_name"`
+	IsActive *bool   `json:"is_active"`
+}
+
+// DeletedUsername - имя, которое получает пользователь после удаления.
+const DeletedUsername = "deleted user"
+
+type UserDelete struct {
+	UserId string `json:"user_id" valid:"required,stringlength(1|64)~user_id length 1..64"`
+}
+
+type UserDeleteResult struct {
+	UserId        string                  `json:"user_id"`
+	Reassignments []*ReviewerReassignment `json:"reassignments"`
+	Summary       *DeactivationSummary    `json:"summary"`
+}
+
+// UserListFilter - фильтры и пагинация /users/list, пустые поля не ограничивают выборку.
+type UserListFilter struct {
+	TeamName string
+	IsActive *bool
+	Search   string
+	Limit    int
+	Offset   int
+}
+
+type UserList struct {
+	Users  []*User `json:"users"`
+	Total  int     `json:"total"`
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+}
+
 type DeactivateUsers struct {
-	TeamName string   `json:"team_name" valid:"stringlength(1|128)~team_name length 1..128"`
-	UserIds  []string `json:"users_ids"`
+	TeamName      string                  `json:"team_name" valid:"stringlength(1|128)~team_name length 1..128"`
+	UserIds       []string                `json:"users_ids"`
+	DryRun        bool                    `json:"dry_run,omitempty"`
+	Reassignments []*ReviewerReassignment `json:"reassignments"`
+	Summary       *DeactivationSummary    `json:"summary,omitempty"`
+}
+
+type ReviewCandidate struct {
+	UserId         string `json:"user_id"`
+	TeamName       string `json:"team_name"`
+	OpenReviews    int    `json:"open_reviews"`
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+}
+
+type DeactivationSummary struct {
+	AffectedPullRequests int `json:"affected_pull_requests"`
+	Reassigned           int `json:"reassigned"`
+	WithoutReplacement   int `json:"without_replacement"`
+}
+
+type ReactivateUsers struct {
+	TeamName  string   `json:"team_name" valid:"stringlength(1|128)~team_name length 1..128"`
+	UserIds   []string `json:"users_ids"`
+	Rebalance bool     `json:"rebalance"`
+	DryRun    bool     `json:"dry_run"`
+}
+
+type ReactivateUsersResult struct {
+	TeamName string          `json:"team_name"`
+	UserIds  []string        `json:"users_ids"`
+	DryRun   bool            `json:"dry_run"`
+	Moves    []*ReviewerMove `json:"moves"`
 }
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Типы доменных событий, на которые можно подписаться.
+const (
+	EventTypePullRequestCreated  = "pull_request.created"
+	EventTypeReviewerAssigned    = "reviewer.assigned"
+	EventTypeReviewerReassigned  = "reviewer.reassigned"
+	EventTypeReviewerRemoved     = "reviewer.removed"
+	EventTypePullRequestMerged   = "pull_request.merged"
+	EventTypePullRequestClosed   = "pull_request.closed"
+	EventTypePullRequestReopened = "pull_request.reopened"
+)
+
+var DomainEventTypes = []string{
+	EventTypePullRequestCreated,
+	EventTypeReviewerAssigned,
+	EventTypeReviewerReassigned,
+	EventTypeReviewerRemoved,
+	EventTypePullRequestMerged,
+	EventTypePullRequestClosed,
+	EventTypePullRequestReopened,
+}
+
+// DomainEvent - событие, которое отправляется подписчикам вебхуков.
+// IdempotencyKey совпадает у всех повторных доставок одного события.
+type DomainEvent struct {
+	IdempotencyKey string    `json:"idempotency_key,omitempty"`
+	Type           string    `json:"type"`
+	PullRequestId  string    `json:"pull_request_id"`
+	ReviewerId     string    `json:"reviewer_id,omitempty"`
+	OldReviewerId  string    `json:"old_reviewer_id,omitempty"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+type WebhookSubscriptionCreate struct {
+	Url        string   `json:"url" valid:"required~url is required,requrl~url must be absolute"`
+	Secret     string   `json:"secret" valid:"required~secret is required,stringlength(16|256)~secret length 16..256"`
+	EventTypes []string `json:"event_types"`
+}
+
+// WebhookSubscription - подписка на события. Secret хранится в открытом виде, потому что им
+// подписывается каждая доставка, и наружу не отдается.
+type WebhookSubscription struct {
+	Id         int64     `json:"id"`
+	Url        string    `json:"url"`
+	Secret     string    `json:"-"`
+	EventTypes []string  `json:"event_types"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type WebhookDelete struct {
+	Id int64 `json:"id" valid:"required~id is required"`
+}
+
+// WebhookDeliveryState - итог попыток доставить одно событие одной подписке.
+type WebhookDeliveryState struct {
+	Delivered bool
+	Attempts  int
+}
+
+// WebhookDelivery - одна попытка доставки события подписчику.
+type WebhookDelivery struct {
+	Id             int64           `json:"id"`
+	SubscriptionId int64           `json:"subscription_id"`
+	EventType      string          `json:"event_type"`
+	IdempotencyKey string          `json:"idempotency_key"`
+	Payload        json.RawMessage `json:"payload"`
+	Attempt        int             `json:"attempt"`
+	StatusCode     *int            `json:"status_code"`
+	Error          string          `json:"error"`
+	Success        bool            `json:"success"`
+	CreatedAt      time.Time       `json:"created_at"`
+}
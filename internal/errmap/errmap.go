@@ -0,0 +1,103 @@
+package errmap
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mapping struct {
+	err      error
+	httpCode int
+	grpcCode codes.Code
+}
+
+// mappings - общая для HTTP и gRPC таблица доменных ошибок. Ошибки, которых здесь нет, считаются внутренними.
+var mappings = []mapping{
+	{entity.ErrTeamNameExist, http.StatusConflict, codes.AlreadyExists},
+	{entity.ErrTeamNameNotFound, http.StatusNotFound, codes.NotFound},
+	{entity.ErrUserNotFound, http.StatusNotFound, codes.NotFound},
+	{entity.ErrAuthorOrTeamNotExist, http.StatusNotFound, codes.NotFound},
+	{entity.ErrPullRequestNotExist, http.StatusNotFound, codes.NotFound},
+	{entity.ErrReviewerNotFound, http.StatusNotFound, codes.NotFound},
+	{entity.ErrReviewerNotAssigned, http.StatusNotFound, codes.NotFound},
+	{entity.ErrWebhookNotFound, http.StatusNotFound, codes.NotFound},
+
+	{entity.ErrPullRequestExist, http.StatusConflict, codes.AlreadyExists},
+	{entity.ErrUserExist, http.StatusConflict, codes.AlreadyExists},
+	{entity.ErrUserDeleted, http.StatusConflict, codes.FailedPrecondition},
+	{entity.ErrRequestAlreadyMerged, http.StatusConflict, codes.FailedPrecondition},
+	{entity.ErrPullRequestMerged, http.StatusConflict, codes.FailedPrecondition},
+	{entity.ErrPullRequestNotOpen, http.StatusConflict, codes.FailedPrecondition},
+	{entity.ErrPullRequestNotClosed, http.StatusConflict, codes.FailedPrecondition},
+	{entity.ErrReviewerAlreadyAssigned, http.StatusConflict, codes.AlreadyExists},
+	{entity.ErrReviewerAtCapacity, http.StatusConflict, codes.FailedPrecondition},
+	{entity.ErrTooManyReviewers, http.StatusConflict, codes.FailedPrecondition},
+	{entity.ErrTooFewReviewers, http.StatusConflict, codes.FailedPrecondition},
+	{entity.ErrVersionConflict, http.StatusConflict, codes.Aborted},
+	{entity.ErrReviewersChanged, http.StatusConflict, codes.Aborted},
+
+	{entity.ErrUsersNotSameTeam, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrInvalidReviewCapacity, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrInvalidReviewSla, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrInvalidReviewerLimits, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrInvalidWebhookEvent, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrNothingToUpdate, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrInvalidPagination, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrInvalidFilter, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrInvalidPriority, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrInvalidLabels, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrEmptyPullRequestName, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrReviewerIsAuthor, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrReviewerInactive, http.StatusBadRequest, codes.InvalidArgument},
+	{entity.ErrReviewerNotInTeam, http.StatusBadRequest, codes.InvalidArgument},
+
+	{entity.ErrInvalidSignature, http.StatusUnauthorized, codes.Unauthenticated},
+	{entity.ErrExternalUserNotMapped, http.StatusUnprocessableEntity, codes.FailedPrecondition},
+}
+
+// legacyHTTPCodes - коды, которые API v1 отдает исторически и не меняет ради существующих клиентов.
+var legacyHTTPCodes = []mapping{
+	{err: entity.ErrTeamNameExist, httpCode: http.StatusNotFound},
+}
+
+func lookup(err error) (mapping, bool) {
+	for _, m := range mappings {
+		if errors.Is(err, m.err) {
+			return m, true
+		}
+	}
+	return mapping{}, false
+}
+
+// HTTPStatus - код ответа HTTP API v1 для ошибки usecase'а, 500 для неизвестных ошибок.
+func HTTPStatus(err error) int {
+	for _, m := range legacyHTTPCodes {
+		if errors.Is(err, m.err) {
+			return m.httpCode
+		}
+	}
+	return HTTPStatusV2(err)
+}
+
+// HTTPStatusV2 - код ответа /api/v2, без исторических исключений v1.
+func HTTPStatusV2(err error) int {
+	if m, ok := lookup(err); ok {
+		return m.httpCode
+	}
+	return http.StatusInternalServerError
+}
+
+// GRPCError переводит ошибку usecase'а в статус gRPC с тем же текстом, что и в HTTP-ответе.
+func GRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if m, ok := lookup(err); ok {
+		return status.Error(m.grpcCode, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,48 @@
+package errmap
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, HTTPStatus(entity.ErrPullRequestNotExist))
+	assert.Equal(t, http.StatusConflict, HTTPStatus(fmt.Errorf("reassign: %w", entity.ErrVersionConflict)))
+	assert.Equal(t, http.StatusBadRequest, HTTPStatus(entity.ErrReviewerInactive))
+	assert.Equal(t, http.StatusInternalServerError, HTTPStatus(errors.New("db failure")))
+}
+
+func TestHTTPStatusV2(t *testing.T) {
+	assert.Equal(t, http.StatusNotFound, HTTPStatus(entity.ErrTeamNameExist))
+	assert.Equal(t, http.StatusConflict, HTTPStatusV2(entity.ErrTeamNameExist))
+	assert.Equal(t, http.StatusNotFound, HTTPStatusV2(entity.ErrUserNotFound))
+	assert.Equal(t, http.StatusInternalServerError, HTTPStatusV2(errors.New("db failure")))
+}
+
+func TestGRPCError(t *testing.T) {
+	tests := []struct {
+		err  error
+		code codes.Code
+	}{
+		{entity.ErrTeamNameExist, codes.AlreadyExists},
+		{entity.ErrUserNotFound, codes.NotFound},
+		{entity.ErrReviewerAtCapacity, codes.FailedPrecondition},
+		{entity.ErrInvalidPagination, codes.InvalidArgument},
+		{errors.New("db failure"), codes.Internal},
+	}
+	for _, tt := range tests {
+		st, ok := status.FromError(GRPCError(tt.err))
+		assert.True(t, ok)
+		assert.Equal(t, tt.code, st.Code(), tt.err.Error())
+		assert.Equal(t, tt.err.Error(), st.Message())
+	}
+
+	assert.NoError(t, GRPCError(nil))
+}
@@ -0,0 +1,13 @@
+package eventstream
+
+import (
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// IBroker раздает события подписчикам текущего экземпляра сервиса.
+type IBroker interface {
+	Publish(event *entity.StreamEvent)
+	// Subscribe возвращает канал событий под фильтр и функцию отписки. Канал закрывается при отписке
+	// или если подписчик не успевает читать события.
+	Subscribe(filter *entity.StreamFilter) (<-chan *entity.StreamEvent, func())
+}
@@ -0,0 +1,66 @@
+package broker
+
+import (
+	"sync"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// bufferSize - сколько событий может накопиться у подписчика, прежде чем он будет отключен.
+const bufferSize = 64
+
+type subscriber struct {
+	filter *entity.StreamFilter
+	events chan *entity.StreamEvent
+}
+
+// Broker рассылает события подписчикам в памяти. Медленный подписчик не задерживает остальных:
+// при переполнении буфера его канал закрывается, и клиент переподключается.
+type Broker struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+func NewBroker() *Broker {
+	return &Broker{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (b *Broker) Publish(event *entity.StreamEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for s := range b.subscribers {
+		if !s.filter.Match(event) {
+			continue
+		}
+		select {
+		case s.events <- event:
+		default:
+			delete(b.subscribers, s)
+			close(s.events)
+		}
+	}
+}
+
+func (b *Broker) Subscribe(filter *entity.StreamFilter) (<-chan *entity.StreamEvent, func()) {
+	s := &subscriber{
+		filter: filter,
+		events: make(chan *entity.StreamEvent, bufferSize),
+	}
+
+	b.mu.Lock()
+	b.subscribers[s] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[s]; ok {
+			delete(b.subscribers, s)
+			close(s.events)
+		}
+	}
+	return s.events, unsubscribe
+}
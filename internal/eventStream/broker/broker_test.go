@@ -0,0 +1,54 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublish_DeliversOnlyMatchingEvents(t *testing.T) {
+	b := NewBroker()
+
+	backend, unsubscribeBackend := b.Subscribe(&entity.StreamFilter{TeamName: "backend"})
+	defer unsubscribeBackend()
+	bob, unsubscribeBob := b.Subscribe(&entity.StreamFilter{UserId: "bob"})
+	defer unsubscribeBob()
+
+	event := &entity.StreamEvent{Id: "1", Type: entity.EventTypeReviewerAssigned, TeamName: "backend", AuthorId: "alice", ReviewerId: "carol"}
+	b.Publish(event)
+
+	require.Len(t, backend, 1)
+	assert.Same(t, event, <-backend)
+	assert.Empty(t, bob)
+}
+
+func TestPublish_SlowSubscriberDisconnected(t *testing.T) {
+	b := NewBroker()
+
+	slow, unsubscribe := b.Subscribe(&entity.StreamFilter{})
+	for range bufferSize + 1 {
+		b.Publish(&entity.StreamEvent{})
+	}
+
+	received := 0
+	for range slow {
+		received++
+	}
+	assert.Equal(t, bufferSize, received)
+
+	// Повторная отписка после отключения не паникует на закрытом канале.
+	unsubscribe()
+}
+
+func TestUnsubscribe_ClosesChannel(t *testing.T) {
+	b := NewBroker()
+
+	events, unsubscribe := b.Subscribe(&entity.StreamFilter{})
+	unsubscribe()
+	b.Publish(&entity.StreamEvent{})
+
+	_, ok := <-events
+	assert.False(t, ok)
+}
@@ -0,0 +1,85 @@
+package http
+
+import (
+	stdjson "encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	eventstream "github.com/Mockird31/avito_tech/internal/eventStream"
+	json "github.com/Mockird31/avito_tech/pkg/json"
+)
+
+const (
+	// heartbeatInterval - как часто отправлять комментарий, чтобы прокси не закрывали простаивающее соединение
+	heartbeatInterval = 15 * time.Second
+	// retryMillis - через сколько браузер переподключится после разрыва
+	retryMillis = 3000
+)
+
+type Handler struct {
+	usecase eventstream.IUsecase
+}
+
+func NewHandler(usecase eventstream.IUsecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+// Stream отдает события в формате Server-Sent Events, пока клиент не отключится.
+func (h *Handler) Stream(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "streaming is not supported")
+		return
+	}
+
+	values := r.URL.Query()
+	filter := &entity.StreamFilter{
+		TeamName: values.Get("team_name"),
+		UserId:   values.Get("user_id"),
+	}
+
+	events, unsubscribe, err := h.usecase.Subscribe(ctx, filter)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, "retry: %d\n\n", retryMillis)
+	flusher.Flush()
+
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			// канал закрыт брокером: клиент не успевал читать и переподключится сам
+			if !ok {
+				return
+			}
+			data, err := stdjson.Marshal(event)
+			if err != nil {
+				return
+			}
+			fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.Id, event.Type, data)
+		case <-ticker.C:
+			fmt.Fprint(w, ": ping\n\n")
+		}
+		flusher.Flush()
+	}
+}
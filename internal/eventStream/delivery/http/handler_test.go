@@ -0,0 +1,74 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	mock_eventstream "github.com/Mockird31/avito_tech/mocks/eventstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandler_Stream(t *testing.T) {
+	occurredAt := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(m *mock_eventstream.MockIUsecase)
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:  "streams events until channel closed",
+			query: "?team_name=backend",
+			mockSetup: func(m *mock_eventstream.MockIUsecase) {
+				events := make(chan *entity.StreamEvent, 1)
+				events <- &entity.StreamEvent{Id: "k1", Type: entity.EventTypePullRequestMerged, PullRequestId: "pr1", PullRequestName: "Add search", AuthorId: "u1", TeamName: "backend", OccurredAt: occurredAt}
+				close(events)
+				m.EXPECT().
+					Subscribe(mock.Anything, &entity.StreamFilter{TeamName: "backend"}).
+					Return((<-chan *entity.StreamEvent)(events), func() {}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody: "retry: 3000\n\n" +
+				"id: k1\nevent: pull_request.merged\n" +
+				`data: {"id":"k1","type":"pull_request.merged","pull_request_id":"pr1","pull_request_name":"Add search","author_id":"u1","team_name":"backend","occurred_at":"2025-03-10T12:00:00Z"}` + "\n\n",
+		},
+		{
+			name:  "unknown user",
+			query: "?user_id=ghost",
+			mockSetup: func(m *mock_eventstream.MockIUsecase) {
+				m.EXPECT().
+					Subscribe(mock.Anything, &entity.StreamFilter{UserId: "ghost"}).
+					Return(nil, nil, entity.ErrUserNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       `{"error":{"code":404,"message":"resource not found"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase := mock_eventstream.NewMockIUsecase(t)
+			tt.mockSetup(mockUsecase)
+			handler := NewHandler(mockUsecase)
+
+			req := httptest.NewRequest(http.MethodGet, "/events/stream"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			handler.Stream(rec, req)
+
+			assert.Equal(t, tt.wantStatusCode, rec.Code)
+			if tt.wantStatusCode == http.StatusOK {
+				assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+				assert.Equal(t, tt.wantBody, rec.Body.String())
+			} else {
+				assert.JSONEq(t, tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
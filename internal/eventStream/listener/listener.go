@@ -0,0 +1,74 @@
+package listener
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	eventstream "github.com/Mockird31/avito_tech/internal/eventStream"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	minReconnectInterval = time.Second
+	maxReconnectInterval = time.Minute
+	// pingInterval - как часто проверять соединение, если уведомлений давно не было
+	pingInterval = 90 * time.Second
+)
+
+// Listener слушает канал NOTIFY с доменными событиями и передает их в usecase.
+// Каждый экземпляр сервиса держит свое соединение, поэтому события, записанные любым экземпляром,
+// доходят до подписчиков всех экземпляров. События, пришедшие во время переподключения, теряются.
+type Listener struct {
+	usecase eventstream.IUsecase
+	dsn     string
+}
+
+func NewListener(usecase eventstream.IUsecase, dsn string) *Listener {
+	return &Listener{
+		usecase: usecase,
+		dsn:     dsn,
+	}
+}
+
+// Run слушает канал, пока не отменен ctx.
+func (l *Listener) Run(ctx context.Context) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	listener := pq.NewListener(l.dsn, minReconnectInterval, maxReconnectInterval, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Warn("event stream listener connection problem (Listener)", zap.Error(err), zap.Int("event", int(event)))
+		}
+	})
+	defer listener.Close()
+
+	if err := listener.Listen(entity.DomainEventsChannel); err != nil {
+		logger.Error("failed to listen for domain events (Listener)", zap.Error(err))
+		return
+	}
+
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case notification := <-listener.Notify:
+			// nil приходит после переподключения, пропущенные за это время события не восстановить
+			if notification == nil {
+				logger.Info("event stream listener reconnected (Listener)")
+				continue
+			}
+			if err := l.usecase.HandleNotification(ctx, notification.Extra); err != nil {
+				logger.Warn("failed to handle domain event notification (Listener)", zap.Error(err))
+			}
+		case <-ticker.C:
+			if err := listener.Ping(); err != nil {
+				logger.Warn("event stream listener ping failed (Listener)", zap.Error(err))
+			}
+		}
+	}
+}
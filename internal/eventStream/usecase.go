@@ -0,0 +1,12 @@
+package eventstream
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+type IUsecase interface {
+	Subscribe(ctx context.Context, filter *entity.StreamFilter) (<-chan *entity.StreamEvent, func(), error)
+	HandleNotification(ctx context.Context, payload string) error
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	eventstream "github.com/Mockird31/avito_tech/internal/eventStream"
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	"github.com/Mockird31/avito_tech/internal/team"
+	"github.com/Mockird31/avito_tech/internal/user"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type usecase struct {
+	PRRepository   pullrequest.IRepository
+	UserRepository user.IRepository
+	TeamRepository team.IRepository
+	Broker         eventstream.IBroker
+}
+
+func NewUsecase(PRRepository pullrequest.IRepository, UserRepository user.IRepository, TeamRepository team.IRepository, Broker eventstream.IBroker) eventstream.IUsecase {
+	return &usecase{
+		PRRepository:   PRRepository,
+		UserRepository: UserRepository,
+		TeamRepository: TeamRepository,
+		Broker:         Broker,
+	}
+}
+
+func (u *usecase) Subscribe(ctx context.Context, filter *entity.StreamFilter) (<-chan *entity.StreamEvent, func(), error) {
+	if filter.TeamName != "" {
+		isExist, err := u.TeamRepository.CheckTeamNameExist(ctx, filter.TeamName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isExist {
+			return nil, nil, entity.ErrTeamNameNotFound
+		}
+	}
+	if filter.UserId != "" {
+		isExist, err := u.UserRepository.CheckUserExistById(ctx, filter.UserId)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isExist {
+			return nil, nil, entity.ErrUserNotFound
+		}
+	}
+
+	events, unsubscribe := u.Broker.Subscribe(filter)
+	return events, unsubscribe, nil
+}
+
+// HandleNotification разбирает событие из канала NOTIFY, дополняет его названием pull request'а
+// и командой автора и раздает подписчикам этого экземпляра.
+func (u *usecase) HandleNotification(ctx context.Context, payload string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var event entity.DomainEvent
+	if err := json.Unmarshal([]byte(payload), &event); err != nil {
+		logger.Error("failed to unmarshal notification (HandleNotification)", zap.Error(err))
+		return err
+	}
+
+	pullRequest, err := u.PRRepository.GetPullRequestById(ctx, event.PullRequestId)
+	if err != nil {
+		return err
+	}
+	author, err := u.UserRepository.GetUserById(ctx, pullRequest.AuthorId)
+	if err != nil {
+		return err
+	}
+
+	u.Broker.Publish(&entity.StreamEvent{
+		Id:              event.IdempotencyKey,
+		Type:            event.Type,
+		PullRequestId:   pullRequest.Id,
+		PullRequestName: pullRequest.PrName,
+		AuthorId:        pullRequest.AuthorId,
+		TeamName:        author.TeamName,
+		ReviewerId:      event.ReviewerId,
+		OldReviewerId:   event.OldReviewerId,
+		OccurredAt:      event.OccurredAt,
+	})
+	return nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	eventstream "github.com/Mockird31/avito_tech/internal/eventStream"
+	mock_eventstream "github.com/Mockird31/avito_tech/mocks/eventstream"
+	mock_pullrequest "github.com/Mockird31/avito_tech/mocks/pullrequest"
+	mock_team "github.com/Mockird31/avito_tech/mocks/team"
+	mock_user "github.com/Mockird31/avito_tech/mocks/user"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupTest(t *testing.T) (eventstream.IUsecase, *mock_pullrequest.MockIRepository, *mock_user.MockIRepository, *mock_team.MockIRepository, *mock_eventstream.MockIBroker) {
+	prRepo := mock_pullrequest.NewMockIRepository(t)
+	userRepo := mock_user.NewMockIRepository(t)
+	teamRepo := mock_team.NewMockIRepository(t)
+	broker := mock_eventstream.NewMockIBroker(t)
+	return NewUsecase(prRepo, userRepo, teamRepo, broker), prRepo, userRepo, teamRepo, broker
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestSubscribe_UnknownTeam(t *testing.T) {
+	uc, _, _, teamRepo, _ := setupTest(t)
+	ctx := getTestContext()
+
+	teamRepo.EXPECT().CheckTeamNameExist(mock.Anything, "ghosts").Return(false, nil)
+
+	events, unsubscribe, err := uc.Subscribe(ctx, &entity.StreamFilter{TeamName: "ghosts"})
+	require.ErrorIs(t, err, entity.ErrTeamNameNotFound)
+	assert.Nil(t, events)
+	assert.Nil(t, unsubscribe)
+}
+
+func TestSubscribe_UnknownUser(t *testing.T) {
+	uc, _, userRepo, _, _ := setupTest(t)
+	ctx := getTestContext()
+
+	userRepo.EXPECT().CheckUserExistById(mock.Anything, "ghost").Return(false, nil)
+
+	_, _, err := uc.Subscribe(ctx, &entity.StreamFilter{UserId: "ghost"})
+	require.ErrorIs(t, err, entity.ErrUserNotFound)
+}
+
+func TestSubscribe_Success(t *testing.T) {
+	uc, _, userRepo, teamRepo, broker := setupTest(t)
+	ctx := getTestContext()
+
+	filter := &entity.StreamFilter{TeamName: "backend", UserId: "u1"}
+	teamRepo.EXPECT().CheckTeamNameExist(mock.Anything, "backend").Return(true, nil)
+	userRepo.EXPECT().CheckUserExistById(mock.Anything, "u1").Return(true, nil)
+	channel := make(chan *entity.StreamEvent)
+	broker.EXPECT().Subscribe(filter).Return((<-chan *entity.StreamEvent)(channel), func() {})
+
+	events, unsubscribe, err := uc.Subscribe(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, (<-chan *entity.StreamEvent)(channel), events)
+	assert.NotNil(t, unsubscribe)
+}
+
+func TestHandleNotification_PublishesEnrichedEvent(t *testing.T) {
+	uc, prRepo, userRepo, _, broker := setupTest(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", PrName: "Add search", AuthorId: "u1"}, nil)
+	userRepo.EXPECT().GetUserById(mock.Anything, "u1").
+		Return(&entity.User{UserId: "u1", TeamName: "backend"}, nil)
+	broker.EXPECT().Publish(&entity.StreamEvent{
+		Id:              "k1",
+		Type:            entity.EventTypeReviewerReassigned,
+		PullRequestId:   "pr1",
+		PullRequestName: "Add search",
+		AuthorId:        "u1",
+		TeamName:        "backend",
+		ReviewerId:      "u3",
+		OldReviewerId:   "u2",
+		OccurredAt:      time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC),
+	})
+
+	payload := `{"idempotency_key":"k1","type":"reviewer.reassigned","pull_request_id":"pr1","reviewer_id":"u3","old_reviewer_id":"u2","occurred_at":"2025-03-10T12:00:00Z"}`
+	err := uc.HandleNotification(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestHandleNotification_InvalidPayload(t *testing.T) {
+	uc, _, _, _, _ := setupTest(t)
+	ctx := getTestContext()
+
+	err := uc.HandleNotification(ctx, "not json")
+	require.Error(t, err)
+}
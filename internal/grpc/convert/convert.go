@@ -0,0 +1,123 @@
+package convert
+
+import (
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/grpc/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Timestamp возвращает nil для nil-времени, чтобы поле осталось непереданным.
+func Timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func Time(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// IntPtr переводит optional-поле protobuf в nil-значение entity.
+func IntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+func Int32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
+
+// PullRequestFilter разбирает те же параметры, что и httpquery.PullRequestFilter в HTTP API.
+func PullRequestFilter(f *pb.PullRequestFilter) (*entity.PullRequestFilter, error) {
+	filter := &entity.PullRequestFilter{}
+	if f == nil {
+		return filter, nil
+	}
+
+	filter.Status = f.GetStatus()
+	filter.Order = f.GetOrder()
+	filter.CreatedFrom = Time(f.GetCreatedFrom())
+	filter.CreatedTo = Time(f.GetCreatedTo())
+	filter.MergedFrom = Time(f.GetMergedFrom())
+	filter.MergedTo = Time(f.GetMergedTo())
+	filter.Limit = int(f.GetLimit())
+
+	if cursor := f.GetCursor(); cursor != "" {
+		var err error
+		if filter.Cursor, err = entity.DecodePullRequestCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	return filter, nil
+}
+
+func PullRequestShort(pr *entity.PullRequestShort) *pb.PullRequestShort {
+	return &pb.PullRequestShort{
+		PullRequestId:   pr.Id,
+		PullRequestName: pr.PrName,
+		AuthorId:        pr.AuthorId,
+		Status:          pr.Status,
+		CreatedAt:       timestamppb.New(pr.CreatedAt),
+		MergedAt:        Timestamp(pr.MergedAt),
+	}
+}
+
+func PullRequestShorts(prs []*entity.PullRequestShort) []*pb.PullRequestShort {
+	result := make([]*pb.PullRequestShort, 0, len(prs))
+	for _, pr := range prs {
+		result = append(result, PullRequestShort(pr))
+	}
+	return result
+}
+
+func ReviewerReassignments(reassignments []*entity.ReviewerReassignment) []*pb.ReviewerReassignment {
+	result := make([]*pb.ReviewerReassignment, 0, len(reassignments))
+	for _, r := range reassignments {
+		result = append(result, &pb.ReviewerReassignment{
+			PullRequestId: r.PullRequestId,
+			OldReviewerId: r.OldReviewerId,
+			NewReviewerId: r.NewReviewerId,
+			Result:        r.Result,
+			Reason:        r.Reason,
+		})
+	}
+	return result
+}
+
+func DeactivationSummary(summary *entity.DeactivationSummary) *pb.DeactivationSummary {
+	if summary == nil {
+		return nil
+	}
+	return &pb.DeactivationSummary{
+		AffectedPullRequests: int32(summary.AffectedPullRequests),
+		Reassigned:           int32(summary.Reassigned),
+		WithoutReplacement:   int32(summary.WithoutReplacement),
+	}
+}
+
+func ReviewerMoves(moves []*entity.ReviewerMove) []*pb.ReviewerMove {
+	result := make([]*pb.ReviewerMove, 0, len(moves))
+	for _, m := range moves {
+		result = append(result, &pb.ReviewerMove{
+			PullRequestId:  m.PullRequestId,
+			FromReviewerId: m.FromReviewerId,
+			ToReviewerId:   m.ToReviewerId,
+		})
+	}
+	return result
+}
@@ -0,0 +1,42 @@
+package httpquery
+
+import (
+	"net/url"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/pkg/query"
+)
+
+// PullRequestFilter разбирает общие параметры списков pull request'ов: статус, диапазоны дат,
+// порядок сортировки, limit и cursor.
+func PullRequestFilter(values url.Values) (*entity.PullRequestFilter, error) {
+	filter := &entity.PullRequestFilter{
+		Status: values.Get("status"),
+		Order:  values.Get("order"),
+	}
+
+	var err error
+	if filter.CreatedFrom, err = query.Time(values, "created_from"); err != nil {
+		return nil, err
+	}
+	if filter.CreatedTo, err = query.Time(values, "created_to"); err != nil {
+		return nil, err
+	}
+	if filter.MergedFrom, err = query.Time(values, "merged_from"); err != nil {
+		return nil, err
+	}
+	if filter.MergedTo, err = query.Time(values, "merged_to"); err != nil {
+		return nil, err
+	}
+	if filter.Limit, err = query.Int(values, "limit"); err != nil {
+		return nil, err
+	}
+
+	if cursor := values.Get("cursor"); cursor != "" {
+		if filter.Cursor, err = entity.DecodePullRequestCursor(cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	return filter, nil
+}
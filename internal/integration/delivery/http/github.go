@@ -0,0 +1,74 @@
+package http
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+const (
+	// GithubSignatureHeader содержит HMAC-SHA256 тела на секрете вебхука: "sha256=<hex>".
+	GithubSignatureHeader = "X-Hub-Signature-256"
+	GithubEventHeader     = "X-GitHub-Event"
+
+	githubPullRequestEvent = "pull_request"
+)
+
+type githubPullRequestPayload struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+func verifyGithubSignature(secret string, payload []byte, signature string) bool {
+	if secret == "" || !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	got, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// parseGithubPullRequest приводит событие pull_request к общему виду. GitHub присылает merge
+// как closed с merged = true.
+func parseGithubPullRequest(payload []byte) (*entity.ExternalPullRequestEvent, error) {
+	var event githubPullRequestPayload
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	if event.Repository.FullName == "" || event.Number <= 0 {
+		return nil, errors.New("repository and number are required")
+	}
+
+	action := event.Action
+	if action == entity.ExternalActionClosed && event.PullRequest.Merged {
+		action = entity.ExternalActionMerged
+	}
+
+	return &entity.ExternalPullRequestEvent{
+		Provider:       entity.ProviderGithub,
+		Action:         action,
+		PullRequestId:  (&entity.ExternalPullRequestRef{Provider: entity.ProviderGithub, Repository: event.Repository.FullName, Number: event.Number}).PullRequestId(),
+		Title:          event.PullRequest.Title,
+		AuthorUsername: event.PullRequest.User.Login,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+const (
+	// GitlabTokenHeader содержит секретный токен вебхука в открытом виде, GitLab не подписывает тело.
+	GitlabTokenHeader = "X-Gitlab-Token"
+	GitlabEventHeader = "X-Gitlab-Event"
+
+	gitlabMergeRequestEvent = "Merge Request Hook"
+)
+
+var gitlabActions = map[string]string{
+	"open":   entity.ExternalActionOpened,
+	"reopen": entity.ExternalActionReopened,
+	"close":  entity.ExternalActionClosed,
+	"merge":  entity.ExternalActionMerged,
+}
+
+type gitlabMergeRequestPayload struct {
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		Iid      int    `json:"iid"`
+		Title    string `json:"title"`
+		Action   string `json:"action"`
+		AuthorId int64  `json:"author_id"`
+	} `json:"object_attributes"`
+}
+
+func verifyGitlabToken(token string, got string) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(got)) == 1
+}
+
+// parseGitlabMergeRequest приводит событие Merge Request Hook к общему виду. Поле user - тот, кто
+// вызвал событие, а не автор, поэтому автор берется из object_attributes.author_id.
+func parseGitlabMergeRequest(payload []byte) (*entity.ExternalPullRequestEvent, error) {
+	var event gitlabMergeRequestPayload
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return nil, err
+	}
+	if event.Project.PathWithNamespace == "" || event.ObjectAttributes.Iid <= 0 {
+		return nil, errors.New("project and iid are required")
+	}
+
+	action, ok := gitlabActions[event.ObjectAttributes.Action]
+	if !ok {
+		action = event.ObjectAttributes.Action
+	}
+
+	return &entity.ExternalPullRequestEvent{
+		Provider:      entity.ProviderGitlab,
+		Action:        action,
+		PullRequestId: (&entity.ExternalPullRequestRef{Provider: entity.ProviderGitlab, Repository: event.Project.PathWithNamespace, Number: event.ObjectAttributes.Iid}).PullRequestId(),
+		Title:         event.ObjectAttributes.Title,
+		AuthorId:      event.ObjectAttributes.AuthorId,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package http
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/integration"
+	json "github.com/Mockird31/avito_tech/pkg/json"
+)
+
+type Handler struct {
+	usecase      integration.IUsecase
+	githubSecret string
+	gitlabToken  string
+}
+
+func NewHandler(usecase integration.IUsecase, cfg config.IntegrationConfig) *Handler {
+	return &Handler{
+		usecase:      usecase,
+		githubSecret: cfg.GithubWebhookSecret,
+		gitlabToken:  cfg.GitlabWebhookToken,
+	}
+}
+
+func (h *Handler) Github(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, json.MaxBytes))
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to read request")
+		return
+	}
+
+	if !verifyGithubSignature(h.githubSecret, payload, r.Header.Get(GithubSignatureHeader)) {
+		json.WriteErrorJson(w, http.StatusUnauthorized, entity.ErrInvalidSignature.Error())
+		return
+	}
+
+	eventName := r.Header.Get(GithubEventHeader)
+	if eventName != githubPullRequestEvent {
+		json.WriteJSON(w, http.StatusOK, ignoredEvent(eventName), nil)
+		return
+	}
+
+	event, err := parseGithubPullRequest(payload)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	h.handlePullRequestEvent(w, r, event)
+}
+
+func (h *Handler) Gitlab(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, json.MaxBytes))
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to read request")
+		return
+	}
+
+	if !verifyGitlabToken(h.gitlabToken, r.Header.Get(GitlabTokenHeader)) {
+		json.WriteErrorJson(w, http.StatusUnauthorized, entity.ErrInvalidSignature.Error())
+		return
+	}
+
+	eventName := r.Header.Get(GitlabEventHeader)
+	if eventName != gitlabMergeRequestEvent {
+		json.WriteJSON(w, http.StatusOK, ignoredEvent(eventName), nil)
+		return
+	}
+
+	event, err := parseGitlabMergeRequest(payload)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	h.handlePullRequestEvent(w, r, event)
+}
+
+func (h *Handler) handlePullRequestEvent(w http.ResponseWriter, r *http.Request, event *entity.ExternalPullRequestEvent) {
+	ctx := r.Context()
+
+	result, err := h.usecase.HandlePullRequestEvent(ctx, event)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.IntegrationResponse{Result: result}, nil)
+}
+
+// ignoredEvent - ответ на события, отличные от pull request'ов (например, ping при настройке вебхука):
+// хостинг получает 200 и не считает доставку ошибкой.
+func ignoredEvent(eventName string) *entity.IntegrationResponse {
+	return &entity.IntegrationResponse{
+		Result: &entity.IntegrationResult{
+			Outcome: entity.IntegrationOutcomeIgnored,
+			Reason:  "unsupported event " + eventName,
+		},
+	}
+}
+
+func (h *Handler) SetUserMapping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var mapping entity.ExternalUserMapping
+
+	err := json.ReadJSON(w, r, &mapping)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(mapping)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	saved, err := h.usecase.SetUserMapping(ctx, &mapping)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserMappingResponse{Mapping: saved}, nil)
+}
+
+func (h *Handler) ListUserMappings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	mappings, err := h.usecase.ListUserMappings(ctx, r.URL.Query().Get("provider"))
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserMappingListResponse{Mappings: mappings}, nil)
+}
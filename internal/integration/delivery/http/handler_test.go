@@ -0,0 +1,170 @@
+package http
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	mock_integration "github.com/Mockird31/avito_tech/mocks/integration"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testGithubSecret = "github-secret"
+	testGitlabToken  = "gitlab-token"
+)
+
+func githubSignature(payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(testGithubSecret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHandler_Github(t *testing.T) {
+	mergedPayload := []byte(`{"action":"closed","number":42,"pull_request":{"title":"Add search","merged":true,"user":{"login":"octocat"}},"repository":{"full_name":"acme/api"}}`)
+
+	tests := []struct {
+		name           string
+		payload        []byte
+		signature      string
+		event          string
+		mockSetup      func(m *mock_integration.MockIUsecase)
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "invalid_signature",
+			payload:        mergedPayload,
+			signature:      "sha256=00",
+			event:          "pull_request",
+			mockSetup:      func(m *mock_integration.MockIUsecase) {},
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       `{"error":{"code":401,"message":"invalid webhook signature"}}`,
+		},
+		{
+			name:           "ping_ignored",
+			payload:        []byte(`{"zen":"Keep it logically awesome."}`),
+			signature:      githubSignature([]byte(`{"zen":"Keep it logically awesome."}`)),
+			event:          "ping",
+			mockSetup:      func(m *mock_integration.MockIUsecase) {},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"result":{"outcome":"ignored","reason":"unsupported event ping"}}`,
+		},
+		{
+			name:      "closed_merged_maps_to_merge",
+			payload:   mergedPayload,
+			signature: githubSignature(mergedPayload),
+			event:     "pull_request",
+			mockSetup: func(m *mock_integration.MockIUsecase) {
+				m.EXPECT().
+					HandlePullRequestEvent(mock.Anything, &entity.ExternalPullRequestEvent{
+						Provider:       entity.ProviderGithub,
+						Action:         entity.ExternalActionMerged,
+						PullRequestId:  "github:acme/api#42",
+						Title:          "Add search",
+						AuthorUsername: "octocat",
+					}).
+					Return(&entity.IntegrationResult{PullRequestId: "github:acme/api#42", Outcome: entity.IntegrationOutcomeMerged}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"result":{"pull_request_id":"github:acme/api#42","outcome":"merged"}}`,
+		},
+		{
+			name:      "author_not_mapped",
+			payload:   mergedPayload,
+			signature: githubSignature(mergedPayload),
+			event:     "pull_request",
+			mockSetup: func(m *mock_integration.MockIUsecase) {
+				m.EXPECT().
+					HandlePullRequestEvent(mock.Anything, mock.Anything).
+					Return(nil, entity.ErrExternalUserNotMapped)
+			},
+			wantStatusCode: http.StatusUnprocessableEntity,
+			wantBody:       `{"error":{"code":422,"message":"external user is not mapped to user_id"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := mock_integration.NewMockIUsecase(t)
+			tt.mockSetup(uc)
+			h := NewHandler(uc, config.IntegrationConfig{GithubWebhookSecret: testGithubSecret})
+
+			req := httptest.NewRequest(http.MethodPost, "/integrations/github", bytes.NewReader(tt.payload))
+			req.Header.Set(GithubSignatureHeader, tt.signature)
+			req.Header.Set(GithubEventHeader, tt.event)
+			rr := httptest.NewRecorder()
+
+			h.Github(rr, req)
+
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+			assert.JSONEq(t, tt.wantBody, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_Gitlab(t *testing.T) {
+	openPayload := []byte(`{"object_kind":"merge_request","user":{"username":"maintainer"},"project":{"path_with_namespace":"acme/api"},"object_attributes":{"iid":7,"title":"Fix login","action":"open","author_id":42}}`)
+
+	tests := []struct {
+		name           string
+		token          string
+		mockSetup      func(m *mock_integration.MockIUsecase)
+		wantStatusCode int
+	}{
+		{
+			name:           "wrong_token",
+			token:          "guess",
+			mockSetup:      func(m *mock_integration.MockIUsecase) {},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+		{
+			name:  "open_maps_to_opened_with_author_id",
+			token: testGitlabToken,
+			mockSetup: func(m *mock_integration.MockIUsecase) {
+				m.EXPECT().
+					HandlePullRequestEvent(mock.Anything, &entity.ExternalPullRequestEvent{
+						Provider:      entity.ProviderGitlab,
+						Action:        entity.ExternalActionOpened,
+						PullRequestId: "gitlab:acme/api!7",
+						Title:         "Fix login",
+						AuthorId:      42,
+					}).
+					Return(&entity.IntegrationResult{PullRequestId: "gitlab:acme/api!7", Outcome: entity.IntegrationOutcomeCreated}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := mock_integration.NewMockIUsecase(t)
+			tt.mockSetup(uc)
+			h := NewHandler(uc, config.IntegrationConfig{GitlabWebhookToken: testGitlabToken})
+
+			req := httptest.NewRequest(http.MethodPost, "/integrations/gitlab", bytes.NewReader(openPayload))
+			req.Header.Set(GitlabTokenHeader, tt.token)
+			req.Header.Set(GitlabEventHeader, "Merge Request Hook")
+			rr := httptest.NewRecorder()
+
+			h.Gitlab(rr, req)
+
+			assert.Equal(t, tt.wantStatusCode, rr.Code)
+		})
+	}
+}
+
+func TestVerifyGithubSignature_EmptySecretRejects(t *testing.T) {
+	payload := []byte(`{}`)
+	mac := hmac.New(sha256.New, []byte(""))
+	mac.Write(payload)
+
+	assert.False(t, verifyGithubSignature("", payload, "sha256="+hex.EncodeToString(mac.Sum(nil))))
+}
@@ -0,0 +1,15 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+type IRepository interface {
+	SetUserMapping(ctx context.Context, mapping *entity.ExternalUserMapping) error
+	ListUserMappings(ctx context.Context, provider string) ([]*entity.ExternalUserMapping, error)
+	GetUserIdByExternalUsername(ctx context.Context, provider, externalUsername string) (string, error)
+	GetUserIdByExternalUserId(ctx context.Context, provider string, externalUserId int64) (string, error)
+	GetExternalUsernamesByUserIds(ctx context.Context, provider string, userIds []string) (map[string]string, error)
+}
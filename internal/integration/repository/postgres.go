@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/integration"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	SetUserMappingQuery = `
+		INSERT INTO external_user_mapping (provider, external_username, user_id, external_user_id)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (provider, external_username) DO UPDATE SET user_id = EXCLUDED.user_id, external_user_id = EXCLUDED.external_user_id;
+	`
+	ListUserMappingsQuery = `
+		SELECT provider, external_username, user_id, COALESCE(external_user_id, 0)
+		FROM external_user_mapping
+		WHERE $1 = '' OR provider = $1
+		ORDER BY provider, external_username;
+	`
+	GetUserIdByExternalUsernameQuery = `
+		SELECT m.user_id
+		FROM external_user_mapping m
+		JOIN "user" u ON u.id = m.user_id
+		WHERE m.provider = $1 AND m.external_username = $2 AND u.deleted_at IS NULL;
+	`
+	GetUserIdByExternalUserIdQuery = `
+		SELECT m.user_id
+		FROM external_user_mapping m
+		JOIN "user" u ON u.id = m.user_id
+		WHERE m.provider = $1 AND m.external_user_id = $2 AND u.deleted_at IS NULL;
+	`
+	// GetExternalUsernamesByUserIdsQuery - если пользователю сопоставлено несколько имен, берется самое раннее
+	GetExternalUsernamesByUserIdsQuery = `
+		SELECT DISTINCT ON (user_id) user_id, external_username
+		FROM external_user_mapping
+		WHERE provider = $1 AND user_id = ANY($2)
+		ORDER BY user_id, created_at, external_username;
+	`
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) integration.IRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) SetUserMapping(ctx context.Context, mapping *entity.ExternalUserMapping) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var externalUserId any
+	if mapping.ExternalUserId != 0 {
+		externalUserId = mapping.ExternalUserId
+	}
+
+	_, err := r.db.ExecContext(ctx, SetUserMappingQuery, mapping.Provider, mapping.ExternalUsername, mapping.UserId, externalUserId)
+	if err != nil {
+		logger.Error("failed to set user mapping (SetUserMapping)", zap.Error(err), zap.String("provider", mapping.Provider), zap.String("external_username", mapping.ExternalUsername))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) ListUserMappings(ctx context.Context, provider string) (mappings []*entity.ExternalUserMapping, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, ListUserMappingsQuery, provider)
+	if err != nil {
+		logger.Error("failed to list user mappings (ListUserMappings)", zap.Error(err), zap.String("provider", provider))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (ListUserMappings)", zap.Error(err))
+		}
+	}()
+
+	mappings = make([]*entity.ExternalUserMapping, 0)
+	for rows.Next() {
+		var mapping entity.ExternalUserMapping
+		if err := rows.Scan(&mapping.Provider, &mapping.ExternalUsername, &mapping.UserId, &mapping.ExternalUserId); err != nil {
+			logger.Error("scan error (ListUserMappings)", zap.Error(err))
+			return nil, err
+		}
+		mappings = append(mappings, &mapping)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (ListUserMappings)", zap.Error(err))
+		return nil, err
+	}
+	return mappings, nil
+}
+
+// GetUserIdByExternalUsername возвращает пустую строку, если соответствие не задано или пользователь удален.
+func (r *repository) GetUserIdByExternalUsername(ctx context.Context, provider, externalUsername string) (string, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var userId string
+	err := r.db.QueryRowContext(ctx, GetUserIdByExternalUsernameQuery, provider, externalUsername).Scan(&userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		logger.Error("failed to get user by external username (GetUserIdByExternalUsername)", zap.Error(err), zap.String("provider", provider), zap.String("external_username", externalUsername))
+		return "", err
+	}
+	return userId, nil
+}
+
+// GetUserIdByExternalUserId возвращает пустую строку, если соответствие не задано или пользователь удален.
+func (r *repository) GetUserIdByExternalUserId(ctx context.Context, provider string, externalUserId int64) (string, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var userId string
+	err := r.db.QueryRowContext(ctx, GetUserIdByExternalUserIdQuery, provider, externalUserId).Scan(&userId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		logger.Error("failed to get user by external user id (GetUserIdByExternalUserId)", zap.Error(err), zap.String("provider", provider), zap.Int64("external_user_id", externalUserId))
+		return "", err
+	}
+	return userId, nil
+}
+
+func (r *repository) GetExternalUsernamesByUserIds(ctx context.Context, provider string, userIds []string) (usernames map[string]string, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, GetExternalUsernamesByUserIdsQuery, provider, pq.Array(userIds))
+	if err != nil {
+		logger.Error("failed to get external usernames (GetExternalUsernamesByUserIds)", zap.Error(err), zap.String("provider", provider))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (GetExternalUsernamesByUserIds)", zap.Error(err))
+		}
+	}()
+
+	usernames = make(map[string]string, len(userIds))
+	for rows.Next() {
+		var userId, username string
+		if err := rows.Scan(&userId, &username); err != nil {
+			logger.Error("scan error (GetExternalUsernamesByUserIds)", zap.Error(err))
+			return nil, err
+		}
+		usernames[userId] = username
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (GetExternalUsernamesByUserIds)", zap.Error(err))
+		return nil, err
+	}
+	return usernames, nil
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *repository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return db, mock, &repository{db: db}
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestSetUserMapping_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(SetUserMappingQuery)).
+		WithArgs(entity.ProviderGithub, "octocat", "u1", nil).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetUserMapping(ctx, &entity.ExternalUserMapping{Provider: entity.ProviderGithub, ExternalUsername: "octocat", UserId: "u1"})
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListUserMappings_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"provider", "external_username", "user_id", "external_user_id"}).
+		AddRow(entity.ProviderGithub, "octocat", "u1", 0).
+		AddRow(entity.ProviderGitlab, "tanuki", "u2", 42)
+	mock.ExpectQuery(regexp.QuoteMeta(ListUserMappingsQuery)).
+		WithArgs("").
+		WillReturnRows(rows)
+
+	got, err := repo.ListUserMappings(ctx, "")
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.ExternalUserMapping{
+		{Provider: entity.ProviderGithub, ExternalUsername: "octocat", UserId: "u1"},
+		{Provider: entity.ProviderGitlab, ExternalUsername: "tanuki", ExternalUserId: 42, UserId: "u2"},
+	}, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUserIdByExternalUsername_NotMapped(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetUserIdByExternalUsernameQuery)).
+		WithArgs(entity.ProviderGitlab, "tanuki").
+		WillReturnError(sql.ErrNoRows)
+
+	got, err := repo.GetUserIdByExternalUsername(ctx, entity.ProviderGitlab, "tanuki")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUserIdByExternalUserId_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetUserIdByExternalUserIdQuery)).
+		WithArgs(entity.ProviderGitlab, int64(42)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow("u2"))
+
+	got, err := repo.GetUserIdByExternalUserId(ctx, entity.ProviderGitlab, 42)
+	require.NoError(t, err)
+	assert.Equal(t, "u2", got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUserIdByExternalUsername_DBError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	dbErr := errors.New("db failure")
+	mock.ExpectQuery(regexp.QuoteMeta(GetUserIdByExternalUsernameQuery)).
+		WithArgs(entity.ProviderGithub, "octocat").
+		WillReturnError(dbErr)
+
+	got, err := repo.GetUserIdByExternalUsername(ctx, entity.ProviderGithub, "octocat")
+	assert.EqualError(t, err, dbErr.Error())
+	assert.Empty(t, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetExternalUsernamesByUserIds_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"user_id", "external_username"}).
+		AddRow("u2", "hubot")
+	mock.ExpectQuery(regexp.QuoteMeta(GetExternalUsernamesByUserIdsQuery)).
+		WithArgs(entity.ProviderGithub, pq.Array([]string{"u2", "u3"})).
+		WillReturnRows(rows)
+
+	got, err := repo.GetExternalUsernamesByUserIds(ctx, entity.ProviderGithub, []string{"u2", "u3"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"u2": "hubot"}, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,13 @@
+package integration
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+type IUsecase interface {
+	HandlePullRequestEvent(ctx context.Context, event *entity.ExternalPullRequestEvent) (*entity.IntegrationResult, error)
+	SetUserMapping(ctx context.Context, mapping *entity.ExternalUserMapping) (*entity.ExternalUserMapping, error)
+	ListUserMappings(ctx context.Context, provider string) ([]*entity.ExternalUserMapping, error)
+}
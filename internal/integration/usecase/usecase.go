@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/integration"
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	"github.com/Mockird31/avito_tech/internal/user"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type usecase struct {
+	IntegrationRepository integration.IRepository
+	UserRepository        user.IRepository
+	PRUsecase             pullrequest.IUsecase
+}
+
+func NewUsecase(IntegrationRepository integration.IRepository, UserRepository user.IRepository, PRUsecase pullrequest.IUsecase) integration.IUsecase {
+	return &usecase{
+		IntegrationRepository: IntegrationRepository,
+		UserRepository:        UserRepository,
+		PRUsecase:             PRUsecase,
+	}
+}
+
+// HandlePullRequestEvent переводит событие хостинга в вызовы тех же usecase'ов, что и /pullRequest/create и /merge,
+// закрытие без merge переводит pull request в статус CLOSED.
+// Хостинги повторяют доставку вебхуков, поэтому уже созданный или неизвестный pull request не считается ошибкой.
+func (u *usecase) HandlePullRequestEvent(ctx context.Context, event *entity.ExternalPullRequestEvent) (*entity.IntegrationResult, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	result := &entity.IntegrationResult{PullRequestId: event.PullRequestId}
+
+	// Идентификатор строится из пути репозитория, и у глубоко вложенных проектов GitLab он может
+	// не поместиться в лимит API, через который потом меняют pull request.
+	if utf8.RuneCountInString(event.PullRequestId) > entity.MaxPullRequestIdLength {
+		logger.Info("external pull request id is too long (HandlePullRequestEvent)", zap.String("provider", event.Provider), zap.String("pr_id", event.PullRequestId))
+		result.Outcome = entity.IntegrationOutcomeIgnored
+		result.Reason = fmt.Sprintf("pull request id is longer than %d characters", entity.MaxPullRequestIdLength)
+		return result, nil
+	}
+
+	switch event.Action {
+	case entity.ExternalActionOpened, entity.ExternalActionReopened:
+		authorId, err := u.getAuthorId(ctx, event)
+		if err != nil {
+			return nil, err
+		}
+		if authorId == "" {
+			logger.Info("external author is not mapped (HandlePullRequestEvent)", zap.String("provider", event.Provider), zap.String("external_username", event.AuthorUsername), zap.Int64("external_user_id", event.AuthorId))
+			return nil, entity.ErrExternalUserNotMapped
+		}
+
+		_, err = u.PRUsecase.CreatePullRequest(ctx, &entity.PullRequest{
+			Id:       event.PullRequestId,
+			PrName:   pullRequestName(event),
+			AuthorId: authorId,
+		})
+		if errors.Is(err, entity.ErrPullRequestExist) {
+			// Закрытый без merge pull request открывается снова, остальное - повторная доставка.
+			_, err = u.PRUsecase.ReopenPullRequest(ctx, &entity.PullRequest{Id: event.PullRequestId})
+			if errors.Is(err, entity.ErrPullRequestNotClosed) {
+				result.Outcome = entity.IntegrationOutcomeIgnored
+				result.Reason = "pull request already exists"
+				return result, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			result.Outcome = entity.IntegrationOutcomeReopened
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Outcome = entity.IntegrationOutcomeCreated
+
+	case entity.ExternalActionMerged:
+		_, err := u.PRUsecase.MergePullRequest(ctx, &entity.PullRequest{Id: event.PullRequestId})
+		if errors.Is(err, entity.ErrPullRequestNotExist) {
+			result.Outcome = entity.IntegrationOutcomeIgnored
+			result.Reason = "unknown pull request"
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Outcome = entity.IntegrationOutcomeMerged
+
+	case entity.ExternalActionClosed:
+		_, err := u.PRUsecase.ClosePullRequest(ctx, &entity.PullRequest{Id: event.PullRequestId})
+		if errors.Is(err, entity.ErrPullRequestNotExist) {
+			result.Outcome = entity.IntegrationOutcomeIgnored
+			result.Reason = "unknown pull request"
+			return result, nil
+		}
+		if errors.Is(err, entity.ErrPullRequestNotOpen) {
+			result.Outcome = entity.IntegrationOutcomeIgnored
+			result.Reason = "pull request is not open"
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Outcome = entity.IntegrationOutcomeClosed
+
+	default:
+		result.Outcome = entity.IntegrationOutcomeIgnored
+		result.Reason = "unsupported action " + event.Action
+	}
+
+	logger.Info("external pull request event handled (HandlePullRequestEvent)", zap.String("provider", event.Provider), zap.String("action", event.Action), zap.String("pr_id", event.PullRequestId), zap.String("outcome", result.Outcome))
+	return result, nil
+}
+
+// pullRequestName обрезает название до лимита API, пустое название заменяется идентификатором.
+func pullRequestName(event *entity.ExternalPullRequestEvent) string {
+	title := strings.TrimSpace(event.Title)
+	if title == "" {
+		return event.PullRequestId
+	}
+	if runes := []rune(title); len(runes) > entity.MaxPullRequestNameLength {
+		return string(runes[:entity.MaxPullRequestNameLength])
+	}
+	return title
+}
+
+// getAuthorId находит автора по числовому идентификатору хостинга, если он передан, иначе по имени.
+func (u *usecase) getAuthorId(ctx context.Context, event *entity.ExternalPullRequestEvent) (string, error) {
+	if event.AuthorId != 0 {
+		return u.IntegrationRepository.GetUserIdByExternalUserId(ctx, event.Provider, event.AuthorId)
+	}
+	return u.IntegrationRepository.GetUserIdByExternalUsername(ctx, event.Provider, event.AuthorUsername)
+}
+
+func (u *usecase) SetUserMapping(ctx context.Context, mapping *entity.ExternalUserMapping) (*entity.ExternalUserMapping, error) {
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, mapping.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !isExist {
+		return nil, entity.ErrUserNotFound
+	}
+
+	err = u.IntegrationRepository.SetUserMapping(ctx, mapping)
+	if err != nil {
+		return nil, err
+	}
+	return mapping, nil
+}
+
+func (u *usecase) ListUserMappings(ctx context.Context, provider string) ([]*entity.ExternalUserMapping, error) {
+	switch provider {
+	case "", entity.ProviderGithub, entity.ProviderGitlab:
+	default:
+		return nil, entity.ErrInvalidFilter
+	}
+	return u.IntegrationRepository.ListUserMappings(ctx, provider)
+}
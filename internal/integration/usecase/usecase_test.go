@@ -0,0 +1,242 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/integration"
+	mock_integration "github.com/Mockird31/avito_tech/mocks/integration"
+	mock_pullrequest "github.com/Mockird31/avito_tech/mocks/pullrequest"
+	mock_user "github.com/Mockird31/avito_tech/mocks/user"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupTest(t *testing.T) (integration.IUsecase, *mock_integration.MockIRepository, *mock_user.MockIRepository, *mock_pullrequest.MockIUsecase) {
+	integrationRepo := mock_integration.NewMockIRepository(t)
+	userRepo := mock_user.NewMockIRepository(t)
+	prUsecase := mock_pullrequest.NewMockIUsecase(t)
+	return NewUsecase(integrationRepo, userRepo, prUsecase), integrationRepo, userRepo, prUsecase
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func openedEvent() *entity.ExternalPullRequestEvent {
+	return &entity.ExternalPullRequestEvent{
+		Provider:       entity.ProviderGithub,
+		Action:         entity.ExternalActionOpened,
+		PullRequestId:  "github:acme/api#42",
+		Title:          "Add search",
+		AuthorUsername: "octocat",
+	}
+}
+
+func TestHandlePullRequestEvent_Opened_CreatesPullRequest(t *testing.T) {
+	uc, integrationRepo, _, prUsecase := setupTest(t)
+	ctx := getTestContext()
+
+	integrationRepo.EXPECT().
+		GetUserIdByExternalUsername(mock.Anything, entity.ProviderGithub, "octocat").
+		Return("u1", nil)
+	prUsecase.EXPECT().
+		CreatePullRequest(mock.Anything, &entity.PullRequest{Id: "github:acme/api#42", PrName: "Add search", AuthorId: "u1"}).
+		Return(&entity.PullRequest{Id: "github:acme/api#42"}, nil)
+
+	got, err := uc.HandlePullRequestEvent(ctx, openedEvent())
+	require.NoError(t, err)
+	assert.Equal(t, &entity.IntegrationResult{PullRequestId: "github:acme/api#42", Outcome: entity.IntegrationOutcomeCreated}, got)
+}
+
+func TestHandlePullRequestEvent_Opened_MapsAuthorById(t *testing.T) {
+	uc, integrationRepo, _, prUsecase := setupTest(t)
+	ctx := getTestContext()
+
+	integrationRepo.EXPECT().
+		GetUserIdByExternalUserId(mock.Anything, entity.ProviderGitlab, int64(42)).
+		Return("u1", nil)
+	prUsecase.EXPECT().
+		CreatePullRequest(mock.Anything, &entity.PullRequest{Id: "gitlab:acme/api!7", PrName: "Fix login", AuthorId: "u1"}).
+		Return(&entity.PullRequest{Id: "gitlab:acme/api!7"}, nil)
+
+	got, err := uc.HandlePullRequestEvent(ctx, &entity.ExternalPullRequestEvent{
+		Provider:      entity.ProviderGitlab,
+		Action:        entity.ExternalActionOpened,
+		PullRequestId: "gitlab:acme/api!7",
+		Title:         "Fix login",
+		AuthorId:      42,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, entity.IntegrationOutcomeCreated, got.Outcome)
+}
+
+func TestHandlePullRequestEvent_Opened_TruncatesLongTitle(t *testing.T) {
+	uc, integrationRepo, _, prUsecase := setupTest(t)
+	ctx := getTestContext()
+
+	event := openedEvent()
+	event.Title = strings.Repeat("я", entity.MaxPullRequestNameLength+10)
+	integrationRepo.EXPECT().
+		GetUserIdByExternalUsername(mock.Anything, entity.ProviderGithub, "octocat").
+		Return("u1", nil)
+	prUsecase.EXPECT().
+		CreatePullRequest(mock.Anything, &entity.PullRequest{Id: "github:acme/api#42", PrName: strings.Repeat("я", entity.MaxPullRequestNameLength), AuthorId: "u1"}).
+		Return(&entity.PullRequest{Id: "github:acme/api#42"}, nil)
+
+	got, err := uc.HandlePullRequestEvent(ctx, event)
+	require.NoError(t, err)
+	assert.Equal(t, entity.IntegrationOutcomeCreated, got.Outcome)
+}
+
+func TestHandlePullRequestEvent_IdTooLong(t *testing.T) {
+	uc, _, _, _ := setupTest(t)
+	ctx := getTestContext()
+
+	event := openedEvent()
+	event.PullRequestId = "gitlab:" + strings.Repeat("group/", 10) + "project!7"
+
+	got, err := uc.HandlePullRequestEvent(ctx, event)
+	require.NoError(t, err)
+	assert.Equal(t, entity.IntegrationOutcomeIgnored, got.Outcome)
+	assert.Equal(t, "pull request id is longer than 64 characters", got.Reason)
+}
+
+func TestHandlePullRequestEvent_Opened_NotMapped(t *testing.T) {
+	uc, integrationRepo, _, _ := setupTest(t)
+	ctx := getTestContext()
+
+	integrationRepo.EXPECT().
+		GetUserIdByExternalUsername(mock.Anything, entity.ProviderGithub, "octocat").
+		Return("", nil)
+
+	got, err := uc.HandlePullRequestEvent(ctx, openedEvent())
+	assert.ErrorIs(t, err, entity.ErrExternalUserNotMapped)
+	assert.Nil(t, got)
+}
+
+func TestHandlePullRequestEvent_Reopened_AlreadyExists(t *testing.T) {
+	uc, integrationRepo, _, prUsecase := setupTest(t)
+	ctx := getTestContext()
+
+	event := openedEvent()
+	event.Action = entity.ExternalActionReopened
+	integrationRepo.EXPECT().
+		GetUserIdByExternalUsername(mock.Anything, entity.ProviderGithub, "octocat").
+		Return("u1", nil)
+	prUsecase.EXPECT().
+		CreatePullRequest(mock.Anything, mock.Anything).
+		Return(nil, entity.ErrPullRequestExist)
+	prUsecase.EXPECT().
+		ReopenPullRequest(mock.Anything, &entity.PullRequest{Id: "github:acme/api#42"}).
+		Return(nil, entity.ErrPullRequestNotClosed)
+
+	got, err := uc.HandlePullRequestEvent(ctx, event)
+	require.NoError(t, err)
+	assert.Equal(t, entity.IntegrationOutcomeIgnored, got.Outcome)
+	assert.Equal(t, "pull request already exists", got.Reason)
+}
+
+func TestHandlePullRequestEvent_Reopened_ClosedPullRequest(t *testing.T) {
+	uc, integrationRepo, _, prUsecase := setupTest(t)
+	ctx := getTestContext()
+
+	event := openedEvent()
+	event.Action = entity.ExternalActionReopened
+	integrationRepo.EXPECT().
+		GetUserIdByExternalUsername(mock.Anything, entity.ProviderGithub, "octocat").
+		Return("u1", nil)
+	prUsecase.EXPECT().
+		CreatePullRequest(mock.Anything, mock.Anything).
+		Return(nil, entity.ErrPullRequestExist)
+	prUsecase.EXPECT().
+		ReopenPullRequest(mock.Anything, &entity.PullRequest{Id: "github:acme/api#42"}).
+		Return(&entity.PullRequest{Id: "github:acme/api#42", Status: "OPEN"}, nil)
+
+	got, err := uc.HandlePullRequestEvent(ctx, event)
+	require.NoError(t, err)
+	assert.Equal(t, entity.IntegrationOutcomeReopened, got.Outcome)
+}
+
+func TestHandlePullRequestEvent_Merged(t *testing.T) {
+	uc, _, _, prUsecase := setupTest(t)
+	ctx := getTestContext()
+
+	prUsecase.EXPECT().
+		MergePullRequest(mock.Anything, &entity.PullRequest{Id: "gitlab:acme/api!7"}).
+		Return(&entity.PullRequest{Id: "gitlab:acme/api!7", Status: "MERGED"}, nil)
+
+	got, err := uc.HandlePullRequestEvent(ctx, &entity.ExternalPullRequestEvent{Provider: entity.ProviderGitlab, Action: entity.ExternalActionMerged, PullRequestId: "gitlab:acme/api!7"})
+	require.NoError(t, err)
+	assert.Equal(t, entity.IntegrationOutcomeMerged, got.Outcome)
+}
+
+func TestHandlePullRequestEvent_Merged_UnknownPullRequest(t *testing.T) {
+	uc, _, _, prUsecase := setupTest(t)
+	ctx := getTestContext()
+
+	prUsecase.EXPECT().
+		MergePullRequest(mock.Anything, mock.Anything).
+		Return(nil, entity.ErrPullRequestNotExist)
+
+	got, err := uc.HandlePullRequestEvent(ctx, &entity.ExternalPullRequestEvent{Provider: entity.ProviderGitlab, Action: entity.ExternalActionMerged, PullRequestId: "gitlab:acme/api!7"})
+	require.NoError(t, err)
+	assert.Equal(t, entity.IntegrationOutcomeIgnored, got.Outcome)
+	assert.Equal(t, "unknown pull request", got.Reason)
+}
+
+func TestHandlePullRequestEvent_Closed(t *testing.T) {
+	uc, _, _, prUsecase := setupTest(t)
+	ctx := getTestContext()
+
+	prUsecase.EXPECT().
+		ClosePullRequest(mock.Anything, &entity.PullRequest{Id: "github:acme/api#42"}).
+		Return(&entity.PullRequest{Id: "github:acme/api#42", Status: "CLOSED"}, nil)
+
+	got, err := uc.HandlePullRequestEvent(ctx, &entity.ExternalPullRequestEvent{Provider: entity.ProviderGithub, Action: entity.ExternalActionClosed, PullRequestId: "github:acme/api#42"})
+	require.NoError(t, err)
+	assert.Equal(t, entity.IntegrationOutcomeClosed, got.Outcome)
+}
+
+func TestHandlePullRequestEvent_Closed_NotOpen(t *testing.T) {
+	uc, _, _, prUsecase := setupTest(t)
+	ctx := getTestContext()
+
+	prUsecase.EXPECT().
+		ClosePullRequest(mock.Anything, mock.Anything).
+		Return(nil, entity.ErrPullRequestNotOpen)
+
+	got, err := uc.HandlePullRequestEvent(ctx, &entity.ExternalPullRequestEvent{Provider: entity.ProviderGithub, Action: entity.ExternalActionClosed, PullRequestId: "github:acme/api#42"})
+	require.NoError(t, err)
+	assert.Equal(t, entity.IntegrationOutcomeIgnored, got.Outcome)
+	assert.Equal(t, "pull request is not open", got.Reason)
+}
+
+func TestSetUserMapping_UserNotFound(t *testing.T) {
+	uc, _, userRepo, _ := setupTest(t)
+	ctx := getTestContext()
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u404").
+		Return(false, nil)
+
+	got, err := uc.SetUserMapping(ctx, &entity.ExternalUserMapping{Provider: entity.ProviderGithub, ExternalUsername: "octocat", UserId: "u404"})
+	assert.ErrorIs(t, err, entity.ErrUserNotFound)
+	assert.Nil(t, got)
+}
+
+func TestListUserMappings_InvalidProvider(t *testing.T) {
+	uc, _, _, _ := setupTest(t)
+	ctx := getTestContext()
+
+	got, err := uc.ListUserMappings(ctx, "bitbucket")
+	assert.ErrorIs(t, err, entity.ErrInvalidFilter)
+	assert.Nil(t, got)
+}
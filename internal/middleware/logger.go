@@ -1,10 +1,12 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 
 	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 )
 
 func Logger(next http.Handler, logger *zap.SugaredLogger) http.Handler {
@@ -21,3 +23,10 @@ func LoggerMiddleware(logger *zap.SugaredLogger) func(http.Handler) http.Handler
 		return Logger(next, logger)
 	}
 }
+
+// LoggerInterceptor - то же, что LoggerMiddleware, для unary-вызовов gRPC.
+func LoggerInterceptor(logger *zap.SugaredLogger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(loggerPkg.LoggerToContext(ctx, logger), req)
+	}
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/asaskevich/govalidator"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/notification"
+	json "github.com/Mockird31/avito_tech/pkg/json"
+)
+
+type Handler struct {
+	usecase notification.IUsecase
+}
+
+func NewHandler(usecase notification.IUsecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) SetSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var settings entity.NotificationSettings
+
+	err := json.ReadJSON(w, r, &settings)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(settings)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	result, err := h.usecase.SetSettings(ctx, &settings)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.NotificationSettingsResponse{Settings: result}, nil)
+}
+
+func (h *Handler) SendDigests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.SendDigests(ctx)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.DigestResponse{Digest: result}, nil)
+}
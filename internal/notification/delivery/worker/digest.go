@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/notification"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// DigestScheduler раз в сутки в заданное время рассылает ревьюверам дайджест открытых ревью.
+// В отличие от периодических задач pull request'ов, не запускается сразу при старте,
+// чтобы перезапуск сервиса не приводил к повторной рассылке. При нескольких экземплярах сервиса
+// дайджест за день рассылает только тот, кто первым закрепил день в базе.
+type DigestScheduler struct {
+	usecase notification.IUsecase
+	// at - смещение от полуночи UTC
+	at time.Duration
+}
+
+func NewDigestScheduler(usecase notification.IUsecase, at time.Duration) *DigestScheduler {
+	return &DigestScheduler{
+		usecase: usecase,
+		at:      at,
+	}
+}
+
+// ParseDigestAt разбирает время рассылки в формате "HH:MM".
+func ParseDigestAt(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Run ждет ближайшего времени рассылки и повторяет ее каждые сутки, пока не отменен ctx.
+func (s *DigestScheduler) Run(ctx context.Context) {
+	for {
+		run := nextRun(time.Now(), s.at)
+		timer := time.NewTimer(time.Until(run))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		s.send(ctx, run)
+	}
+}
+
+func (s *DigestScheduler) send(ctx context.Context, run time.Time) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	result, err := s.usecase.SendDailyDigests(ctx, run)
+	if errors.Is(err, entity.ErrDigestAlreadySent) {
+		logger.Info("digests already sent by another instance (DigestScheduler)", zap.Time("run", run))
+		return
+	}
+	if err != nil {
+		logger.Error("failed to send digests (DigestScheduler)", zap.Error(err))
+		return
+	}
+	logger.Info("digests sent (DigestScheduler)", zap.Int("recipients", result.Recipients), zap.Int("sent", result.Sent), zap.Int("failed", result.Failed))
+}
+
+// nextRun - ближайший после now момент, отстоящий на at от полуночи UTC.
+func nextRun(now time.Time, at time.Duration) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).Add(at)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
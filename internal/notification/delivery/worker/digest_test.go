@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDigestAt(t *testing.T) {
+	at, err := ParseDigestAt("09:30")
+	require.NoError(t, err)
+	assert.Equal(t, 9*time.Hour+30*time.Minute, at)
+
+	_, err = ParseDigestAt("9am")
+	require.Error(t, err)
+}
+
+func TestNextRun(t *testing.T) {
+	at := 9 * time.Hour
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Time
+	}{
+		{"before time today", time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)},
+		{"exactly at time", time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC), time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC)},
+		{"after time today", time.Date(2025, 3, 10, 17, 0, 0, 0, time.UTC), time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC)},
+		{"non-UTC now", time.Date(2025, 3, 10, 11, 0, 0, 0, time.FixedZone("MSK", 3*3600)), time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, nextRun(tt.now, at))
+		})
+	}
+}
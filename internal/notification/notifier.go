@@ -0,0 +1,13 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// INotifier доставляет сообщение пользователю в один канал (чат, лог).
+type INotifier interface {
+	Name() string
+	Notify(ctx context.Context, notification *entity.Notification) error
+}
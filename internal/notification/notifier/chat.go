@@ -0,0 +1,73 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ChatNotifier отправляет сообщения во входящий вебхук в формате Slack, который понимает и Mattermost.
+type ChatNotifier struct {
+	client     *http.Client
+	webhookUrl string
+	direct     bool
+}
+
+func NewChatNotifier(cfg config.NotificationConfig) *ChatNotifier {
+	return &ChatNotifier{
+		client:     &http.Client{Timeout: cfg.ChatTimeout},
+		webhookUrl: cfg.ChatWebhookUrl,
+		direct:     cfg.ChatDirect,
+	}
+}
+
+type chatMessage struct {
+	Text    string `json:"text"`
+	Channel string `json:"channel,omitempty"`
+}
+
+func (n *ChatNotifier) Name() string {
+	return "chat"
+}
+
+func (n *ChatNotifier) Notify(ctx context.Context, notification *entity.Notification) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	message := chatMessage{Text: "@" + notification.Username + " " + notification.Text}
+	if n.direct {
+		message = chatMessage{Text: notification.Text, Channel: "@" + notification.Username}
+	}
+
+	body, err := json.Marshal(&message)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.webhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		logger.Error("chat webhook request failed (Notify)", zap.Error(err), zap.String("user_id", notification.UserId))
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		logger.Error("chat webhook responded with error (Notify)", zap.Int("status_code", resp.StatusCode), zap.String("user_id", notification.UserId))
+		return fmt.Errorf("chat webhook responded %d", resp.StatusCode)
+	}
+	return nil
+}
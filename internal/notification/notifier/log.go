@@ -0,0 +1,29 @@
+package notifier
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// LogNotifier пишет уведомления в лог сервиса.
+type LogNotifier struct{}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Name() string {
+	return "log"
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, notification *entity.Notification) error {
+	loggerPkg.LoggerFromContext(ctx).Info("notification",
+		zap.String("kind", notification.Kind),
+		zap.String("user_id", notification.UserId),
+		zap.String("pr_id", notification.PullRequestId),
+		zap.String("text", notification.Text))
+	return nil
+}
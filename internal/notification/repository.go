@@ -0,0 +1,15 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+type IRepository interface {
+	SetEnabled(ctx context.Context, userId string, enabled bool) error
+	GetOptedOutUserIds(ctx context.Context, userIds []string) (map[string]struct{}, error)
+	GetDigestRecipients(ctx context.Context) ([]*entity.User, error)
+	ClaimDigestRun(ctx context.Context, day time.Time) (bool, error)
+}
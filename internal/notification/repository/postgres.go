@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/notification"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	OptOutQuery = `
+		INSERT INTO notification_opt_out (user_id)
+		VALUES ($1)
+		ON CONFLICT (user_id) DO NOTHING;
+	`
+	OptInQuery = `
+		DELETE FROM notification_opt_out
+		WHERE user_id = $1;
+	`
+	GetOptedOutUserIdsQuery = `
+		SELECT user_id
+		FROM notification_opt_out
+		WHERE user_id = ANY($1);
+	`
+	// GetDigestRecipientsQuery - активные пользователи, не отказавшиеся от уведомлений, у которых есть открытые ревью
+	GetDigestRecipientsQuery = `
+		SELECT u.id, u.username, u.team_name, u.is_active
+		FROM "user" u
+		WHERE u.is_active AND u.deleted_at IS NULL
+			AND NOT EXISTS (SELECT 1 FROM notification_opt_out o WHERE o.user_id = u.id)
+			AND EXISTS (
+				SELECT 1
+				FROM pull_request_reviewers prr
+				JOIN pull_request pr ON pr.id = prr.pull_request_id
+				WHERE prr.reviewer_id = u.id AND pr.status = 'OPEN'
+			)
+		ORDER BY u.id;
+	`
+	ClaimDigestRunQuery = `
+		INSERT INTO notification_digest_run (day)
+		VALUES ($1)
+		ON CONFLICT (day) DO NOTHING;
+	`
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) notification.IRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) SetEnabled(ctx context.Context, userId string, enabled bool) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	query := OptOutQuery
+	if enabled {
+		query = OptInQuery
+	}
+
+	_, err := r.db.ExecContext(ctx, query, userId)
+	if err != nil {
+		logger.Error("failed to set notifications enabled (SetEnabled)", zap.Error(err), zap.String("user_id", userId), zap.Bool("enabled", enabled))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) GetOptedOutUserIds(ctx context.Context, userIds []string) (optedOut map[string]struct{}, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, GetOptedOutUserIdsQuery, pq.Array(userIds))
+	if err != nil {
+		logger.Error("failed to get opted out users (GetOptedOutUserIds)", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (GetOptedOutUserIds)", zap.Error(err))
+		}
+	}()
+
+	optedOut = make(map[string]struct{})
+	for rows.Next() {
+		var userId string
+		if err := rows.Scan(&userId); err != nil {
+			logger.Error("scan error (GetOptedOutUserIds)", zap.Error(err))
+			return nil, err
+		}
+		optedOut[userId] = struct{}{}
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (GetOptedOutUserIds)", zap.Error(err))
+		return nil, err
+	}
+	return optedOut, nil
+}
+
+func (r *repository) GetDigestRecipients(ctx context.Context) (users []*entity.User, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, GetDigestRecipientsQuery)
+	if err != nil {
+		logger.Error("failed to get digest recipients (GetDigestRecipients)", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (GetDigestRecipients)", zap.Error(err))
+		}
+	}()
+
+	users = make([]*entity.User, 0)
+	for rows.Next() {
+		var user entity.User
+		if err := rows.Scan(&user.UserId, &user.Username, &user.TeamName, &user.IsActive); err != nil {
+			logger.Error("scan error (GetDigestRecipients)", zap.Error(err))
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (GetDigestRecipients)", zap.Error(err))
+		return nil, err
+	}
+	return users, nil
+}
+
+// ClaimDigestRun закрепляет рассылку дайджеста за день: true получает только первый из экземпляров сервиса.
+func (r *repository) ClaimDigestRun(ctx context.Context, day time.Time) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	res, err := r.db.ExecContext(ctx, ClaimDigestRunQuery, day.UTC().Format(time.DateOnly))
+	if err != nil {
+		logger.Error("failed to claim digest run (ClaimDigestRun)", zap.Error(err), zap.Time("day", day))
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.Error("failed to get rows affected (ClaimDigestRun)", zap.Error(err))
+		return false, err
+	}
+	return affected == 1, nil
+}
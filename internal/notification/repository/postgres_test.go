@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *repository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return db, mock, &repository{db: db}
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestSetEnabled_OptOut(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(OptOutQuery)).
+		WithArgs("u1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetEnabled(ctx, "u1", false)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetEnabled_OptIn(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(OptInQuery)).
+		WithArgs("u1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetEnabled(ctx, "u1", true)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOptedOutUserIds_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetOptedOutUserIdsQuery)).
+		WithArgs(pq.Array([]string{"u1", "u2"})).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow("u2"))
+
+	optedOut, err := repo.GetOptedOutUserIds(ctx, []string{"u1", "u2"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]struct{}{"u2": {}}, optedOut)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDigestRecipients_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"id", "username", "team_name", "is_active"}).
+		AddRow("u1", "alice", "backend", true).
+		AddRow("u2", "bob", "backend", true)
+	mock.ExpectQuery(regexp.QuoteMeta(GetDigestRecipientsQuery)).
+		WillReturnRows(rows)
+
+	users, err := repo.GetDigestRecipients(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.User{
+		{UserId: "u1", Username: "alice", TeamName: "backend", IsActive: true},
+		{UserId: "u2", Username: "bob", TeamName: "backend", IsActive: true},
+	}, users)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDigestRecipients_DbError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetDigestRecipientsQuery)).
+		WillReturnError(errors.New("db error"))
+
+	users, err := repo.GetDigestRecipients(ctx)
+	require.Error(t, err)
+	assert.Nil(t, users)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimDigestRun(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	day := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	mock.ExpectExec(regexp.QuoteMeta(ClaimDigestRunQuery)).
+		WithArgs("2025-03-10").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(ClaimDigestRunQuery)).
+		WithArgs("2025-03-10").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	claimed, err := repo.ClaimDigestRun(ctx, day)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.ClaimDigestRun(ctx, day)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
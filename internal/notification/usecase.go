@@ -0,0 +1,15 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+type IUsecase interface {
+	HandleEvent(ctx context.Context, event *entity.DomainEvent) error
+	SendDigests(ctx context.Context) (*entity.DigestResult, error)
+	SendDailyDigests(ctx context.Context, day time.Time) (*entity.DigestResult, error)
+	SetSettings(ctx context.Context, settings *entity.NotificationSettings) (*entity.NotificationSettings, error)
+}
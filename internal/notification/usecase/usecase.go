@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/notification"
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	"github.com/Mockird31/avito_tech/internal/user"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// digestLimit - сколько открытых ревью перечисляется в дайджесте, остальные сворачиваются в "and more".
+const digestLimit = 20
+
+type usecase struct {
+	NotificationRepository notification.IRepository
+	UserRepository         user.IRepository
+	PRRepository           pullrequest.IRepository
+	Notifiers              []notification.INotifier
+}
+
+func NewUsecase(NotificationRepository notification.IRepository, UserRepository user.IRepository, PRRepository pullrequest.IRepository, Notifiers ...notification.INotifier) notification.IUsecase {
+	return &usecase{
+		NotificationRepository: NotificationRepository,
+		UserRepository:         UserRepository,
+		PRRepository:           PRRepository,
+		Notifiers:              Notifiers,
+	}
+}
+
+// HandleEvent уведомляет назначенного ревьювера, а при переназначении - еще и ревьювера, с которого сняли ревью.
+// Деактивированные, удаленные и отказавшиеся от уведомлений пользователи пропускаются.
+func (u *usecase) HandleEvent(ctx context.Context, event *entity.DomainEvent) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var userIds []string
+	switch event.Type {
+	case entity.EventTypeReviewerAssigned:
+		userIds = []string{event.ReviewerId}
+	case entity.EventTypeReviewerReassigned:
+		userIds = []string{event.ReviewerId, event.OldReviewerId}
+	default:
+		return nil
+	}
+
+	pullRequest, err := u.PRRepository.GetPullRequestById(ctx, event.PullRequestId)
+	if err != nil {
+		return err
+	}
+
+	users, err := u.UserRepository.GetUsersByIds(ctx, userIds)
+	if err != nil {
+		return err
+	}
+
+	optedOut, err := u.NotificationRepository.GetOptedOutUserIds(ctx, userIds)
+	if err != nil {
+		return err
+	}
+
+	var notifications []*entity.Notification
+	if reviewer, ok := users[event.ReviewerId]; ok {
+		notifications = append(notifications, &entity.Notification{
+			Kind:          entity.NotificationKindAssigned,
+			UserId:        reviewer.UserId,
+			Username:      reviewer.Username,
+			PullRequestId: pullRequest.Id,
+			Text:          fmt.Sprintf("you were assigned to review %q (%s)", pullRequest.PrName, pullRequest.Id),
+		})
+	}
+	if oldReviewer, ok := users[event.OldReviewerId]; ok && event.OldReviewerId != "" {
+		text := fmt.Sprintf("%q (%s) was reassigned from you", pullRequest.PrName, pullRequest.Id)
+		if reviewer, ok := users[event.ReviewerId]; ok {
+			text += " to " + reviewer.Username
+		}
+		notifications = append(notifications, &entity.Notification{
+			Kind:          entity.NotificationKindReassignedAway,
+			UserId:        oldReviewer.UserId,
+			Username:      oldReviewer.Username,
+			PullRequestId: pullRequest.Id,
+			Text:          text,
+		})
+	}
+
+	var errs []error
+	for _, n := range notifications {
+		if _, ok := optedOut[n.UserId]; ok || !users[n.UserId].IsActive {
+			logger.Debug("notification skipped (HandleEvent)", zap.String("user_id", n.UserId), zap.String("kind", n.Kind))
+			continue
+		}
+		if err := u.notify(ctx, n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SendDigests отправляет каждому активному ревьюверу список его открытых ревью, от самых старых.
+// Ошибка доставки одному пользователю не прерывает рассылку и учитывается в Failed.
+func (u *usecase) SendDigests(ctx context.Context) (*entity.DigestResult, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	recipients, err := u.NotificationRepository.GetDigestRecipients(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity.DigestResult{Recipients: len(recipients)}
+	for _, recipient := range recipients {
+		filter := &entity.PullRequestFilter{
+			ReviewerId: recipient.UserId,
+			Status:     entity.StatusOpen.String(),
+			Order:      entity.SortOrderAsc,
+			Limit:      digestLimit + 1,
+		}
+		pullRequests, err := u.PRRepository.GetPullRequestsByReviewerId(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+		if len(pullRequests) == 0 {
+			continue
+		}
+
+		err = u.notify(ctx, &entity.Notification{
+			Kind:     entity.NotificationKindDigest,
+			UserId:   recipient.UserId,
+			Username: recipient.Username,
+			Text:     digestText(pullRequests),
+		})
+		if err != nil {
+			logger.Warn("failed to send digest (SendDigests)", zap.Error(err), zap.String("user_id", recipient.UserId))
+			result.Failed++
+			continue
+		}
+		result.Sent++
+	}
+
+	return result, nil
+}
+
+// SendDailyDigests рассылает дайджест за день, если его еще не разослал другой экземпляр сервиса.
+// День закрепляется до рассылки, поэтому при падении посреди нее дайджест за этот день не повторяется.
+func (u *usecase) SendDailyDigests(ctx context.Context, day time.Time) (*entity.DigestResult, error) {
+	claimed, err := u.NotificationRepository.ClaimDigestRun(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, entity.ErrDigestAlreadySent
+	}
+	return u.SendDigests(ctx)
+}
+
+func digestText(pullRequests []*entity.PullRequestShort) string {
+	var b strings.Builder
+	b.WriteString("your open reviews:")
+	for i, pullRequest := range pullRequests {
+		if i == digestLimit {
+			b.WriteString("\n• and more")
+			break
+		}
+		fmt.Fprintf(&b, "\n• %q (%s), opened %s", pullRequest.PrName, pullRequest.Id, pullRequest.CreatedAt.UTC().Format("2006-01-02"))
+	}
+	return b.String()
+}
+
+func (u *usecase) SetSettings(ctx context.Context, settings *entity.NotificationSettings) (*entity.NotificationSettings, error) {
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, settings.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !isExist {
+		return nil, entity.ErrUserNotFound
+	}
+
+	err = u.NotificationRepository.SetEnabled(ctx, settings.UserId, settings.Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// notify доставляет уведомление во все каналы и возвращает ошибки тех, что не приняли его.
+func (u *usecase) notify(ctx context.Context, n *entity.Notification) error {
+	var errs []error
+	for _, notifier := range u.Notifiers {
+		if err := notifier.Notify(ctx, n); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", notifier.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,223 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/notification"
+	mock_notification "github.com/Mockird31/avito_tech/mocks/notification"
+	mock_pullrequest "github.com/Mockird31/avito_tech/mocks/pullrequest"
+	mock_user "github.com/Mockird31/avito_tech/mocks/user"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testDeps struct {
+	notificationRepo *mock_notification.MockIRepository
+	userRepo         *mock_user.MockIRepository
+	prRepo           *mock_pullrequest.MockIRepository
+	notifier         *mock_notification.MockINotifier
+}
+
+func setupTest(t *testing.T) (notification.IUsecase, *testDeps) {
+	deps := &testDeps{
+		notificationRepo: mock_notification.NewMockIRepository(t),
+		userRepo:         mock_user.NewMockIRepository(t),
+		prRepo:           mock_pullrequest.NewMockIRepository(t),
+		notifier:         mock_notification.NewMockINotifier(t),
+	}
+	return NewUsecase(deps.notificationRepo, deps.userRepo, deps.prRepo, deps.notifier), deps
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestHandleEvent_Assigned_NotifiesReviewer(t *testing.T) {
+	uc, deps := setupTest(t)
+	ctx := getTestContext()
+
+	deps.prRepo.EXPECT().GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", PrName: "Add search"}, nil)
+	deps.userRepo.EXPECT().GetUsersByIds(mock.Anything, []string{"u2"}).
+		Return(map[string]*entity.User{"u2": {UserId: "u2", Username: "bob", IsActive: true}}, nil)
+	deps.notificationRepo.EXPECT().GetOptedOutUserIds(mock.Anything, []string{"u2"}).
+		Return(map[string]struct{}{}, nil)
+	deps.notifier.EXPECT().Notify(mock.Anything, &entity.Notification{
+		Kind:          entity.NotificationKindAssigned,
+		UserId:        "u2",
+		Username:      "bob",
+		PullRequestId: "pr1",
+		Text:          `you were assigned to review "Add search" (pr1)`,
+	}).Return(nil)
+
+	err := uc.HandleEvent(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: "pr1", ReviewerId: "u2"})
+	require.NoError(t, err)
+}
+
+func TestHandleEvent_Reassigned_NotifiesBothReviewers(t *testing.T) {
+	uc, deps := setupTest(t)
+	ctx := getTestContext()
+
+	deps.prRepo.EXPECT().GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", PrName: "Add search"}, nil)
+	deps.userRepo.EXPECT().GetUsersByIds(mock.Anything, []string{"u3", "u2"}).
+		Return(map[string]*entity.User{
+			"u2": {UserId: "u2", Username: "bob", IsActive: true},
+			"u3": {UserId: "u3", Username: "carol", IsActive: true},
+		}, nil)
+	deps.notificationRepo.EXPECT().GetOptedOutUserIds(mock.Anything, []string{"u3", "u2"}).
+		Return(map[string]struct{}{}, nil)
+	deps.notifier.EXPECT().Notify(mock.Anything, mock.MatchedBy(func(n *entity.Notification) bool {
+		return n.Kind == entity.NotificationKindAssigned && n.UserId == "u3"
+	})).Return(nil)
+	deps.notifier.EXPECT().Notify(mock.Anything, &entity.Notification{
+		Kind:          entity.NotificationKindReassignedAway,
+		UserId:        "u2",
+		Username:      "bob",
+		PullRequestId: "pr1",
+		Text:          `"Add search" (pr1) was reassigned from you to carol`,
+	}).Return(nil)
+
+	err := uc.HandleEvent(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerReassigned, PullRequestId: "pr1", ReviewerId: "u3", OldReviewerId: "u2"})
+	require.NoError(t, err)
+}
+
+func TestHandleEvent_OptedOutAndInactiveSkipped(t *testing.T) {
+	uc, deps := setupTest(t)
+	ctx := getTestContext()
+
+	deps.prRepo.EXPECT().GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", PrName: "Add search"}, nil)
+	deps.userRepo.EXPECT().GetUsersByIds(mock.Anything, []string{"u3", "u2"}).
+		Return(map[string]*entity.User{
+			"u2": {UserId: "u2", Username: "bob", IsActive: false},
+			"u3": {UserId: "u3", Username: "carol", IsActive: true},
+		}, nil)
+	deps.notificationRepo.EXPECT().GetOptedOutUserIds(mock.Anything, []string{"u3", "u2"}).
+		Return(map[string]struct{}{"u3": {}}, nil)
+
+	err := uc.HandleEvent(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerReassigned, PullRequestId: "pr1", ReviewerId: "u3", OldReviewerId: "u2"})
+	require.NoError(t, err)
+}
+
+func TestHandleEvent_NotifierError_Returned(t *testing.T) {
+	uc, deps := setupTest(t)
+	ctx := getTestContext()
+
+	deps.prRepo.EXPECT().GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", PrName: "Add search"}, nil)
+	deps.userRepo.EXPECT().GetUsersByIds(mock.Anything, []string{"u2"}).
+		Return(map[string]*entity.User{"u2": {UserId: "u2", Username: "bob", IsActive: true}}, nil)
+	deps.notificationRepo.EXPECT().GetOptedOutUserIds(mock.Anything, []string{"u2"}).
+		Return(map[string]struct{}{}, nil)
+	notifyErr := errors.New("chat webhook responded 500")
+	deps.notifier.EXPECT().Notify(mock.Anything, mock.Anything).Return(notifyErr)
+	deps.notifier.EXPECT().Name().Return("chat")
+
+	err := uc.HandleEvent(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: "pr1", ReviewerId: "u2"})
+	require.ErrorIs(t, err, notifyErr)
+}
+
+func TestHandleEvent_MergedIgnored(t *testing.T) {
+	uc, _ := setupTest(t)
+	ctx := getTestContext()
+
+	err := uc.HandleEvent(ctx, &entity.DomainEvent{Type: entity.EventTypePullRequestMerged, PullRequestId: "pr1"})
+	require.NoError(t, err)
+}
+
+func TestSendDigests_CountsSentAndFailed(t *testing.T) {
+	uc, deps := setupTest(t)
+	ctx := getTestContext()
+
+	deps.notificationRepo.EXPECT().GetDigestRecipients(mock.Anything).
+		Return([]*entity.User{
+			{UserId: "u1", Username: "alice", IsActive: true},
+			{UserId: "u2", Username: "bob", IsActive: true},
+		}, nil)
+	createdAt := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	deps.prRepo.EXPECT().GetPullRequestsByReviewerId(mock.Anything, &entity.PullRequestFilter{ReviewerId: "u1", Status: "OPEN", Order: entity.SortOrderAsc, Limit: digestLimit + 1}).
+		Return([]*entity.PullRequestShort{{Id: "pr1", PrName: "Add search", CreatedAt: createdAt}}, nil)
+	deps.prRepo.EXPECT().GetPullRequestsByReviewerId(mock.Anything, &entity.PullRequestFilter{ReviewerId: "u2", Status: "OPEN", Order: entity.SortOrderAsc, Limit: digestLimit + 1}).
+		Return([]*entity.PullRequestShort{{Id: "pr2", PrName: "Fix login", CreatedAt: createdAt}}, nil)
+	deps.notifier.EXPECT().Notify(mock.Anything, &entity.Notification{
+		Kind:     entity.NotificationKindDigest,
+		UserId:   "u1",
+		Username: "alice",
+		Text:     "your open reviews:\n• \"Add search\" (pr1), opened 2025-03-10",
+	}).Return(nil)
+	deps.notifier.EXPECT().Notify(mock.Anything, mock.MatchedBy(func(n *entity.Notification) bool { return n.UserId == "u2" })).
+		Return(errors.New("timeout"))
+	deps.notifier.EXPECT().Name().Return("chat")
+
+	result, err := uc.SendDigests(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &entity.DigestResult{Recipients: 2, Sent: 1, Failed: 1}, result)
+}
+
+func TestSendDailyDigests_ClaimsDayBeforeSending(t *testing.T) {
+	uc, deps := setupTest(t)
+	ctx := getTestContext()
+
+	day := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	deps.notificationRepo.EXPECT().ClaimDigestRun(mock.Anything, day).Return(true, nil)
+	deps.notificationRepo.EXPECT().GetDigestRecipients(mock.Anything).Return([]*entity.User{}, nil)
+
+	result, err := uc.SendDailyDigests(ctx, day)
+	require.NoError(t, err)
+	assert.Equal(t, &entity.DigestResult{}, result)
+}
+
+func TestSendDailyDigests_AlreadySentByAnotherInstance(t *testing.T) {
+	uc, deps := setupTest(t)
+	ctx := getTestContext()
+
+	day := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	deps.notificationRepo.EXPECT().ClaimDigestRun(mock.Anything, day).Return(false, nil)
+
+	result, err := uc.SendDailyDigests(ctx, day)
+	require.ErrorIs(t, err, entity.ErrDigestAlreadySent)
+	assert.Nil(t, result)
+}
+
+func TestDigestText_TruncatesLongList(t *testing.T) {
+	pullRequests := make([]*entity.PullRequestShort, digestLimit+1)
+	for i := range pullRequests {
+		pullRequests[i] = &entity.PullRequestShort{Id: "pr", PrName: "x"}
+	}
+
+	text := digestText(pullRequests)
+	assert.Contains(t, text, "\n• and more")
+}
+
+func TestSetSettings_UserNotFound(t *testing.T) {
+	uc, deps := setupTest(t)
+	ctx := getTestContext()
+
+	deps.userRepo.EXPECT().CheckUserExistById(mock.Anything, "ghost").Return(false, nil)
+
+	result, err := uc.SetSettings(ctx, &entity.NotificationSettings{UserId: "ghost"})
+	require.ErrorIs(t, err, entity.ErrUserNotFound)
+	assert.Nil(t, result)
+}
+
+func TestSetSettings_OptOut(t *testing.T) {
+	uc, deps := setupTest(t)
+	ctx := getTestContext()
+
+	deps.userRepo.EXPECT().CheckUserExistById(mock.Anything, "u1").Return(true, nil)
+	deps.notificationRepo.EXPECT().SetEnabled(mock.Anything, "u1", false).Return(nil)
+
+	result, err := uc.SetSettings(ctx, &entity.NotificationSettings{UserId: "u1", Enabled: false})
+	require.NoError(t, err)
+	assert.Equal(t, &entity.NotificationSettings{UserId: "u1", Enabled: false}, result)
+}
@@ -0,0 +1,122 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/outbox"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Relay публикует события из outbox во все sink'и. Каждый принявший событие sink запоминается, событие
+// считается опубликованным, когда его приняли все sink'и; иначе позже оно повторяется только для
+// sink'ов с ошибкой. Доставка - at-least-once.
+type Relay struct {
+	repository   outbox.IRepository
+	sinks        []outbox.ISink
+	pollInterval time.Duration
+	batchSize    int
+	lease        time.Duration
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+}
+
+func NewRelay(repository outbox.IRepository, sinks []outbox.ISink, cfg config.OutboxConfig) *Relay {
+	return &Relay{
+		repository:   repository,
+		sinks:        sinks,
+		pollInterval: cfg.PollInterval,
+		batchSize:    cfg.BatchSize,
+		lease:        cfg.Lease,
+		baseBackoff:  cfg.BaseBackoff,
+		maxBackoff:   cfg.MaxBackoff,
+	}
+}
+
+// Run раз в pollInterval разбирает outbox, пока не отменен ctx. Полная пачка означает, что
+// в очереди могут остаться события, и следующая берется сразу.
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			claimed, err := r.RelayBatch(ctx)
+			if err != nil || claimed < r.batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayBatch берет пачку событий и публикует их по порядку. Возвращает число взятых событий.
+func (r *Relay) RelayBatch(ctx context.Context) (int, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	messages, err := r.repository.ClaimPending(ctx, r.batchSize, r.lease)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, message := range messages {
+		if err := r.publish(ctx, message); err != nil {
+			retryIn := r.backoff(message.Attempts)
+			logger.Error("failed to publish outbox event (RelayBatch)",
+				zap.Error(err),
+				zap.Int64("id", message.Id),
+				zap.String("event_type", message.Event.Type),
+				zap.Int("attempts", message.Attempts),
+				zap.Duration("retry_in", retryIn))
+			if markErr := r.repository.MarkFailed(ctx, message.Id, err.Error(), retryIn); markErr != nil {
+				return len(messages), markErr
+			}
+			continue
+		}
+
+		if err := r.repository.MarkPublished(ctx, message.Id); err != nil {
+			return len(messages), err
+		}
+	}
+	return len(messages), nil
+}
+
+// publish отдает событие sink'ам, которые еще не приняли его на прошлых попытках.
+func (r *Relay) publish(ctx context.Context, message *entity.OutboxMessage) error {
+	var errs []error
+	for _, sink := range r.sinks {
+		name := sink.Name()
+		if slices.Contains(message.DeliveredSinks, name) {
+			continue
+		}
+
+		if err := sink.Publish(ctx, message.Event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		if err := r.repository.MarkSinkDelivered(ctx, message.Id, name); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// backoff удваивает задержку с каждой попыткой, не превышая maxBackoff.
+func (r *Relay) backoff(attempts int) time.Duration {
+	delay := r.baseBackoff
+	for i := 1; i < attempts && delay < r.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, r.maxBackoff)
+}
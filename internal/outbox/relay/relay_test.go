@@ -0,0 +1,153 @@
+package relay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/outbox"
+	"github.com/Mockird31/avito_tech/internal/outbox/sink"
+	mock_outbox "github.com/Mockird31/avito_tech/mocks/outbox"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testConfig = config.OutboxConfig{
+	BatchSize:   10,
+	Lease:       time.Minute,
+	BaseBackoff: time.Second,
+	MaxBackoff:  10 * time.Second,
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestRelayBatch_PublishesToAllSinks(t *testing.T) {
+	ctx := getTestContext()
+
+	repo := mock_outbox.NewMockIRepository(t)
+	memory := sink.NewMemorySink()
+	event := &entity.DomainEvent{IdempotencyKey: "key-1", Type: entity.EventTypePullRequestMerged, PullRequestId: "pr1"}
+
+	repo.EXPECT().
+		ClaimPending(mock.Anything, 10, time.Minute).
+		Return([]*entity.OutboxMessage{{Id: 1, Event: event, Attempts: 1}}, nil)
+	repo.EXPECT().
+		MarkSinkDelivered(mock.Anything, int64(1), "log").
+		Return(nil)
+	repo.EXPECT().
+		MarkSinkDelivered(mock.Anything, int64(1), "memory").
+		Return(nil)
+	repo.EXPECT().
+		MarkPublished(mock.Anything, int64(1)).
+		Return(nil)
+
+	relay := NewRelay(repo, []outbox.ISink{sink.NewLogSink(), memory}, testConfig)
+	claimed, err := relay.RelayBatch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, claimed)
+	assert.Equal(t, []*entity.DomainEvent{event}, memory.Events())
+}
+
+func TestRelayBatch_SinkFailure_RetriesWithBackoff(t *testing.T) {
+	ctx := getTestContext()
+
+	repo := mock_outbox.NewMockIRepository(t)
+	failing := mock_outbox.NewMockISink(t)
+	memory := sink.NewMemorySink()
+	event := &entity.DomainEvent{IdempotencyKey: "key-1", Type: entity.EventTypeReviewerAssigned, PullRequestId: "pr1", ReviewerId: "u2"}
+
+	failing.EXPECT().Name().Return("webhook")
+	failing.EXPECT().
+		Publish(mock.Anything, event).
+		Return(errors.New("timeout"))
+	repo.EXPECT().
+		ClaimPending(mock.Anything, 10, time.Minute).
+		Return([]*entity.OutboxMessage{{Id: 1, Event: event, Attempts: 3}}, nil)
+	repo.EXPECT().
+		MarkSinkDelivered(mock.Anything, int64(1), "memory").
+		Return(nil)
+	repo.EXPECT().
+		MarkFailed(mock.Anything, int64(1), "webhook: timeout", 4*time.Second).
+		Return(nil)
+
+	relay := NewRelay(repo, []outbox.ISink{memory, failing}, testConfig)
+	claimed, err := relay.RelayBatch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, claimed)
+	assert.Len(t, memory.Events(), 1)
+}
+
+func TestRelayBatch_RetrySkipsDeliveredSinks(t *testing.T) {
+	ctx := getTestContext()
+
+	repo := mock_outbox.NewMockIRepository(t)
+	delivered := mock_outbox.NewMockISink(t)
+	retried := mock_outbox.NewMockISink(t)
+	event := &entity.DomainEvent{IdempotencyKey: "key-1", Type: entity.EventTypeReviewerAssigned, PullRequestId: "pr1", ReviewerId: "u2"}
+
+	delivered.EXPECT().Name().Return("notify")
+	retried.EXPECT().Name().Return("webhook")
+	retried.EXPECT().
+		Publish(mock.Anything, event).
+		Return(nil)
+	repo.EXPECT().
+		ClaimPending(mock.Anything, 10, time.Minute).
+		Return([]*entity.OutboxMessage{{Id: 1, Event: event, Attempts: 2, DeliveredSinks: []string{"notify"}}}, nil)
+	repo.EXPECT().
+		MarkSinkDelivered(mock.Anything, int64(1), "webhook").
+		Return(nil)
+	repo.EXPECT().
+		MarkPublished(mock.Anything, int64(1)).
+		Return(nil)
+
+	relay := NewRelay(repo, []outbox.ISink{delivered, retried}, testConfig)
+	claimed, err := relay.RelayBatch(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, claimed)
+	delivered.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+}
+
+func TestRelayBatch_ClaimError(t *testing.T) {
+	ctx := getTestContext()
+
+	repo := mock_outbox.NewMockIRepository(t)
+	dbErr := errors.New("db failure")
+	repo.EXPECT().
+		ClaimPending(mock.Anything, 10, time.Minute).
+		Return(nil, dbErr)
+
+	relay := NewRelay(repo, []outbox.ISink{sink.NewMemorySink()}, testConfig)
+	_, err := relay.RelayBatch(ctx)
+	assert.ErrorIs(t, err, dbErr)
+}
+
+func TestBackoff_CappedByMaxBackoff(t *testing.T) {
+	relay := NewRelay(nil, nil, testConfig)
+
+	assert.Equal(t, time.Second, relay.backoff(1))
+	assert.Equal(t, 2*time.Second, relay.backoff(2))
+	assert.Equal(t, 8*time.Second, relay.backoff(4))
+	assert.Equal(t, 10*time.Second, relay.backoff(5))
+	assert.Equal(t, 10*time.Second, relay.backoff(50))
+}
+
+func TestMemorySink_DropsDuplicates(t *testing.T) {
+	ctx := getTestContext()
+	memory := sink.NewMemorySink()
+
+	event := &entity.DomainEvent{IdempotencyKey: "key-1", Type: entity.EventTypePullRequestMerged, PullRequestId: "pr1"}
+	require.NoError(t, memory.Publish(ctx, event))
+	require.NoError(t, memory.Publish(ctx, event))
+
+	assert.Len(t, memory.Events(), 1)
+}
@@ -0,0 +1,16 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+type IRepository interface {
+	AddEvents(ctx context.Context, events []*entity.DomainEvent) error
+	ClaimPending(ctx context.Context, limit int, lease time.Duration) ([]*entity.OutboxMessage, error)
+	MarkSinkDelivered(ctx context.Context, id int64, sink string) error
+	MarkPublished(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, reason string, retryIn time.Duration) error
+}
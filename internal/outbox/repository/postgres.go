@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/outbox"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	// AddEventQuery сохраняет событие и отправляет его в канал $3 для /events/stream
+	AddEventQuery = `
+		WITH inserted AS (
+			INSERT INTO outbox (event_type, payload)
+			VALUES ($1, $2)
+			RETURNING idempotency_key, payload
+		)
+		SELECT pg_notify($3, (payload || jsonb_build_object('idempotency_key', idempotency_key::text))::text)
+		FROM inserted;
+	`
+	// ClaimPendingQuery берет готовые к публикации события и сдвигает их next_attempt_at на время аренды:
+	// параллельный relay их пропустит, а если этот relay упадет, события вернутся в очередь после аренды.
+	ClaimPendingQuery = `
+		UPDATE outbox
+		SET attempts = attempts + 1,
+			next_attempt_at = NOW() + make_interval(secs => $2)
+		WHERE id IN (
+			SELECT id
+			FROM outbox
+			WHERE published_at IS NULL AND next_attempt_at <= NOW()
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, idempotency_key::text, payload, attempts,
+			ARRAY(SELECT sink FROM outbox_sink_delivery d WHERE d.outbox_id = outbox.id);
+	`
+	MarkSinkDeliveredQuery = `
+		INSERT INTO outbox_sink_delivery (outbox_id, sink)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING;
+	`
+	MarkPublishedQuery = `
+		UPDATE outbox
+		SET published_at = NOW(),
+			last_error = ''
+		WHERE id = $1;
+	`
+	MarkFailedQuery = `
+		UPDATE outbox
+		SET last_error = $2,
+			next_attempt_at = NOW() + make_interval(secs => $3)
+		WHERE id = $1;
+	`
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) outbox.IRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+// AddEvents пишет события в транзакцию из ctx, если она открыта, поэтому они сохраняются вместе с изменением.
+func (r *repository) AddEvents(ctx context.Context, events []*entity.DomainEvent) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	for _, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			logger.Error("failed to marshal event (AddEvents)", zap.Error(err), zap.String("event_type", event.Type))
+			return err
+		}
+
+		_, err = postgres.Conn(ctx, r.db).ExecContext(ctx, AddEventQuery, event.Type, payload, entity.DomainEventsChannel)
+		if err != nil {
+			logger.Error("failed to add event to outbox (AddEvents)", zap.Error(err), zap.String("event_type", event.Type), zap.String("pr_id", event.PullRequestId))
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *repository) ClaimPending(ctx context.Context, limit int, lease time.Duration) (messages []*entity.OutboxMessage, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, ClaimPendingQuery, limit, lease.Seconds())
+	if err != nil {
+		logger.Error("failed to claim outbox events (ClaimPending)", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (ClaimPending)", zap.Error(err))
+		}
+	}()
+
+	messages = make([]*entity.OutboxMessage, 0)
+	for rows.Next() {
+		var (
+			message        entity.OutboxMessage
+			idempotencyKey string
+			payload        []byte
+		)
+		if err := rows.Scan(&message.Id, &idempotencyKey, &payload, &message.Attempts, pq.Array(&message.DeliveredSinks)); err != nil {
+			logger.Error("scan error (ClaimPending)", zap.Error(err))
+			return nil, err
+		}
+
+		message.Event = &entity.DomainEvent{}
+		if err := json.Unmarshal(payload, message.Event); err != nil {
+			logger.Error("failed to unmarshal outbox payload (ClaimPending)", zap.Error(err), zap.Int64("id", message.Id))
+			return nil, err
+		}
+		message.Event.IdempotencyKey = idempotencyKey
+		messages = append(messages, &message)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (ClaimPending)", zap.Error(err))
+		return nil, err
+	}
+
+	// RETURNING не сохраняет порядок подзапроса
+	slices.SortFunc(messages, func(a, b *entity.OutboxMessage) int {
+		return cmp.Compare(a.Id, b.Id)
+	})
+	return messages, nil
+}
+
+func (r *repository) MarkSinkDelivered(ctx context.Context, id int64, sink string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	_, err := r.db.ExecContext(ctx, MarkSinkDeliveredQuery, id, sink)
+	if err != nil {
+		logger.Error("failed to mark outbox event delivered to sink (MarkSinkDelivered)", zap.Error(err), zap.Int64("id", id), zap.String("sink", sink))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) MarkPublished(ctx context.Context, id int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	_, err := r.db.ExecContext(ctx, MarkPublishedQuery, id)
+	if err != nil {
+		logger.Error("failed to mark outbox event published (MarkPublished)", zap.Error(err), zap.Int64("id", id))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) MarkFailed(ctx context.Context, id int64, reason string, retryIn time.Duration) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	_, err := r.db.ExecContext(ctx, MarkFailedQuery, id, reason, retryIn.Seconds())
+	if err != nil {
+		logger.Error("failed to mark outbox event failed (MarkFailed)", zap.Error(err), zap.Int64("id", id))
+		return err
+	}
+	return nil
+}
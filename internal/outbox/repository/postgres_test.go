@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *repository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return db, mock, &repository{db: db}
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestAddEvents_WithinTx(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(AddEventQuery)).
+		WithArgs(entity.EventTypeReviewerAssigned, sqlmock.AnyArg(), entity.DomainEventsChannel).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(regexp.QuoteMeta(AddEventQuery)).
+		WithArgs(entity.EventTypeReviewerAssigned, sqlmock.AnyArg(), entity.DomainEventsChannel).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	err := postgres.NewTransactor(db).WithinTx(ctx, func(ctx context.Context) error {
+		return repo.AddEvents(ctx, []*entity.DomainEvent{
+			{Type: entity.EventTypeReviewerAssigned, PullRequestId: "pr1", ReviewerId: "u2"},
+			{Type: entity.EventTypeReviewerAssigned, PullRequestId: "pr1", ReviewerId: "u3"},
+		})
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddEvents_RollbackOnError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	dbErr := errors.New("db failure")
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(AddEventQuery)).
+		WillReturnError(dbErr)
+	mock.ExpectRollback()
+
+	err := postgres.NewTransactor(db).WithinTx(ctx, func(ctx context.Context) error {
+		return repo.AddEvents(ctx, []*entity.DomainEvent{{Type: entity.EventTypePullRequestMerged, PullRequestId: "pr1"}})
+	})
+	assert.ErrorIs(t, err, dbErr)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimPending_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"id", "idempotency_key", "payload", "attempts", "delivered_sinks"}).
+		AddRow(5, "key-5", []byte(`{"type":"pull_request.merged","pull_request_id":"pr2"}`), 1, "{}").
+		AddRow(3, "key-3", []byte(`{"type":"reviewer.assigned","pull_request_id":"pr1","reviewer_id":"u2"}`), 2, "{log,notify}")
+	mock.ExpectQuery(regexp.QuoteMeta(ClaimPendingQuery)).
+		WithArgs(10, float64(60)).
+		WillReturnRows(rows)
+
+	got, err := repo.ClaimPending(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, int64(3), got[0].Id)
+	assert.Equal(t, 2, got[0].Attempts)
+	assert.Equal(t, &entity.DomainEvent{IdempotencyKey: "key-3", Type: entity.EventTypeReviewerAssigned, PullRequestId: "pr1", ReviewerId: "u2"}, got[0].Event)
+	assert.Equal(t, []string{"log", "notify"}, got[0].DeliveredSinks)
+	assert.Equal(t, "key-5", got[1].Event.IdempotencyKey)
+	assert.Empty(t, got[1].DeliveredSinks)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkSinkDelivered_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(MarkSinkDeliveredQuery)).
+		WithArgs(int64(3), "webhook").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.MarkSinkDelivered(ctx, 3, "webhook")
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkFailed_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(MarkFailedQuery)).
+		WithArgs(int64(3), "webhook: timeout", float64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.MarkFailed(ctx, 3, "webhook: timeout", 4*time.Second)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,14 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// ISink - получатель событий из outbox. Одно событие может прийти повторно,
+// поэтому sink должен быть готов к дублям и различать их по IdempotencyKey.
+type ISink interface {
+	Name() string
+	Publish(ctx context.Context, event *entity.DomainEvent) error
+}
@@ -0,0 +1,27 @@
+package sink
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/codehost"
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// CodeHostSink переносит назначения ревьюверов на pull request'ы во внешнем хостинге.
+type CodeHostSink struct {
+	usecase codehost.IUsecase
+}
+
+func NewCodeHostSink(usecase codehost.IUsecase) *CodeHostSink {
+	return &CodeHostSink{
+		usecase: usecase,
+	}
+}
+
+func (s *CodeHostSink) Name() string {
+	return "codehost"
+}
+
+func (s *CodeHostSink) Publish(ctx context.Context, event *entity.DomainEvent) error {
+	return s.usecase.SyncReviewers(ctx, event)
+}
@@ -0,0 +1,30 @@
+package sink
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// LogSink пишет события в лог сервиса.
+type LogSink struct{}
+
+func NewLogSink() *LogSink {
+	return &LogSink{}
+}
+
+func (s *LogSink) Name() string {
+	return "log"
+}
+
+func (s *LogSink) Publish(ctx context.Context, event *entity.DomainEvent) error {
+	loggerPkg.LoggerFromContext(ctx).Info("domain event published",
+		zap.String("idempotency_key", event.IdempotencyKey),
+		zap.String("event_type", event.Type),
+		zap.String("pr_id", event.PullRequestId),
+		zap.String("reviewer_id", event.ReviewerId),
+		zap.String("old_reviewer_id", event.OldReviewerId))
+	return nil
+}
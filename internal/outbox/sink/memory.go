@@ -0,0 +1,47 @@
+package sink
+
+import (
+	"context"
+	"sync"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// MemorySink хранит полученные события в памяти и отбрасывает повторы по IdempotencyKey.
+// Нужен для тестов.
+type MemorySink struct {
+	mu     sync.Mutex
+	seen   map[string]struct{}
+	events []*entity.DomainEvent
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{
+		seen:   make(map[string]struct{}),
+		events: make([]*entity.DomainEvent, 0),
+	}
+}
+
+func (s *MemorySink) Name() string {
+	return "memory"
+}
+
+func (s *MemorySink) Publish(_ context.Context, event *entity.DomainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.seen[event.IdempotencyKey]; ok {
+		return nil
+	}
+	s.seen[event.IdempotencyKey] = struct{}{}
+	s.events = append(s.events, event)
+	return nil
+}
+
+// Events возвращает события в порядке получения, без повторов.
+func (s *MemorySink) Events() []*entity.DomainEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*entity.DomainEvent(nil), s.events...)
+}
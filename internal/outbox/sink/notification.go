@@ -0,0 +1,27 @@
+package sink
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/notification"
+)
+
+// NotificationSink уведомляет ревьюверов о назначениях и переназначениях.
+type NotificationSink struct {
+	usecase notification.IUsecase
+}
+
+func NewNotificationSink(usecase notification.IUsecase) *NotificationSink {
+	return &NotificationSink{
+		usecase: usecase,
+	}
+}
+
+func (s *NotificationSink) Name() string {
+	return "notify"
+}
+
+func (s *NotificationSink) Publish(ctx context.Context, event *entity.DomainEvent) error {
+	return s.usecase.HandleEvent(ctx, event)
+}
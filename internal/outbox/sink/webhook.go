@@ -0,0 +1,27 @@
+package sink
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/webhook"
+)
+
+// WebhookSink доставляет события подписчикам вебхуков.
+type WebhookSink struct {
+	dispatcher webhook.IDispatcher
+}
+
+func NewWebhookSink(dispatcher webhook.IDispatcher) *WebhookSink {
+	return &WebhookSink{
+		dispatcher: dispatcher,
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *WebhookSink) Publish(ctx context.Context, event *entity.DomainEvent) error {
+	return s.dispatcher.Deliver(ctx, event)
+}
@@ -0,0 +1,9 @@
+package outbox
+
+import "context"
+
+// ITransactor выполняет fn в одной транзакции, чтобы изменение данных и запись событий в outbox
+// сохранялись или откатывались вместе.
+type ITransactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,262 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/grpc/convert"
+	"github.com/Mockird31/avito_tech/internal/grpc/pb"
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	"github.com/asaskevich/govalidator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Server struct {
+	pb.UnimplementedPullRequestServiceServer
+	usecase pullrequest.IUsecase
+}
+
+func NewServer(usecase pullrequest.IUsecase) *Server {
+	return &Server{
+		usecase: usecase,
+	}
+}
+
+func (s *Server) GetPullRequest(ctx context.Context, req *pb.GetPullRequestRequest) (*pb.PullRequest, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "pull_request_id is required")
+	}
+
+	pullRequest, err := s.usecase.GetPullRequestById(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return pullRequestToProto(pullRequest), nil
+}
+
+func (s *Server) AddReviewer(ctx context.Context, req *pb.ReviewerChangeRequest) (*pb.PullRequest, error) {
+	reviewerChange, err := reviewerChangeFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequest, err := s.usecase.AddReviewer(ctx, reviewerChange)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return pullRequestToProto(pullRequest), nil
+}
+
+func (s *Server) RemoveReviewer(ctx context.Context, req *pb.ReviewerChangeRequest) (*pb.PullRequest, error) {
+	reviewerChange, err := reviewerChangeFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequest, err := s.usecase.RemoveReviewer(ctx, reviewerChange)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return pullRequestToProto(pullRequest), nil
+}
+
+func (s *Server) UpdatePullRequest(ctx context.Context, req *pb.UpdatePullRequestRequest) (*pb.PullRequest, error) {
+	pullRequestUpdate := &entity.PullRequestUpdate{
+		Id:          req.GetPullRequestId(),
+		Version:     int(req.GetVersion()),
+		PrName:      req.PullRequestName,
+		Description: req.Description,
+		Priority:    req.Priority,
+	}
+	if req.GetLabels() != nil {
+		pullRequestUpdate.Labels = append([]string{}, req.GetLabels().GetValues()...)
+	}
+
+	if _, err := govalidator.ValidateStruct(pullRequestUpdate); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pullRequest, err := s.usecase.UpdatePullRequest(ctx, pullRequestUpdate)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return pullRequestToProto(pullRequest), nil
+}
+
+func (s *Server) ListPullRequests(ctx context.Context, req *pb.ListPullRequestsRequest) (*pb.PullRequestList, error) {
+	filter, err := convert.PullRequestFilter(req.GetFilter())
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+	filter.TeamName = req.GetTeamName()
+	filter.AuthorId = req.GetAuthorId()
+	filter.ReviewerId = req.GetReviewerId()
+	filter.NameSearch = req.GetName()
+
+	pullRequestList, err := s.usecase.ListPullRequests(ctx, filter)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.PullRequestList{
+		PullRequests: convert.PullRequestShorts(pullRequestList.PullRequests),
+		Total:        int32(pullRequestList.Total),
+		NextCursor:   pullRequestList.NextCursor,
+	}, nil
+}
+
+func (s *Server) CreatePullRequest(ctx context.Context, req *pb.CreatePullRequestRequest) (*pb.PullRequest, error) {
+	createPullRequest := &entity.PullRequest{
+		Id:          req.GetPullRequestId(),
+		PrName:      req.GetPullRequestName(),
+		AuthorId:    req.GetAuthorId(),
+		Description: req.GetDescription(),
+		Labels:      req.GetLabels(),
+		Priority:    req.GetPriority(),
+	}
+
+	if _, err := govalidator.ValidateStruct(createPullRequest); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pullRequest, err := s.usecase.CreatePullRequest(ctx, createPullRequest)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return pullRequestToProto(pullRequest), nil
+}
+
+func (s *Server) MergePullRequest(ctx context.Context, req *pb.MergePullRequestRequest) (*pb.PullRequest, error) {
+	mergePullRequest := &entity.PullRequest{Id: req.GetPullRequestId()}
+
+	if _, err := govalidator.ValidateStruct(mergePullRequest); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pullRequest, err := s.usecase.MergePullRequest(ctx, mergePullRequest)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return pullRequestToProto(pullRequest), nil
+}
+
+func (s *Server) ReconcileReviewers(ctx context.Context, _ *pb.ReconcileReviewersRequest) (*pb.ReconcileResult, error) {
+	result, err := s.usecase.ReconcileReviewers(ctx)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	toppedUp := make([]*pb.ReconcileTopUp, 0, len(result.ToppedUp))
+	for _, topUp := range result.ToppedUp {
+		toppedUp = append(toppedUp, &pb.ReconcileTopUp{
+			PullRequestId:    topUp.PullRequestId,
+			AddedReviewerIds: topUp.AddedReviewerIds,
+		})
+	}
+
+	return &pb.ReconcileResult{
+		Checked:        int32(result.Checked),
+		AddedReviewers: int32(result.Added),
+		ToppedUp:       toppedUp,
+	}, nil
+}
+
+func (s *Server) EscalateOverdueReviews(ctx context.Context, _ *pb.EscalateOverdueReviewsRequest) (*pb.OverdueSweepResult, error) {
+	result, err := s.usecase.EscalateOverdueReviews(ctx)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.OverdueSweepResult{
+		Marked:     int32(result.Marked),
+		Reassigned: int32(result.Reassigned),
+	}, nil
+}
+
+func (s *Server) ReassignPullRequest(ctx context.Context, req *pb.ReassignPullRequestRequest) (*pb.ReassignPullRequestResult, error) {
+	pullRequestReassign := &entity.PullRequestReassignRequest{
+		Id:            req.GetPullRequestId(),
+		OldReviewerId: req.GetOldReviewerId(),
+		NewReviewerId: req.GetNewReviewerId(),
+	}
+
+	if _, err := govalidator.ValidateStruct(pullRequestReassign); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.usecase.ReassignPullRequest(ctx, pullRequestReassign)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	reassignResult := &pb.ReassignPullRequestResult{
+		PullRequest: pullRequestToProto(result.PullRequest),
+		ReplacedBy:  result.ReplacedBy,
+		Outcome:     result.Outcome,
+	}
+	if excluded := result.ExcludedCandidates; excluded != nil {
+		reassignResult.ExcludedCandidates = &pb.CandidateExclusions{
+			Author:          int32(excluded.Author),
+			Inactive:        int32(excluded.Inactive),
+			AlreadyAssigned: int32(excluded.AlreadyAssigned),
+			AtCapacity:      int32(excluded.AtCapacity),
+		}
+	}
+	return reassignResult, nil
+}
+
+func reviewerChangeFromProto(req *pb.ReviewerChangeRequest) (*entity.PullRequestReviewerChange, error) {
+	reviewerChange := &entity.PullRequestReviewerChange{
+		Id:         req.GetPullRequestId(),
+		ReviewerId: req.GetReviewerId(),
+	}
+
+	if _, err := govalidator.ValidateStruct(reviewerChange); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return reviewerChange, nil
+}
+
+func pullRequestToProto(pr *entity.PullRequest) *pb.PullRequest {
+	if pr == nil {
+		return nil
+	}
+
+	result := &pb.PullRequest{
+		PullRequestId:     pr.Id,
+		PullRequestName:   pr.PrName,
+		AuthorId:          pr.AuthorId,
+		Status:            pr.Status,
+		AssignedReviewers: pr.AssignedReviewersIds,
+		Description:       pr.Description,
+		Labels:            pr.Labels,
+		Priority:          pr.Priority,
+		Version:           int32(pr.Version),
+		MergedAt:          convert.Timestamp(pr.MergedAt),
+	}
+	if shortfall := pr.ReviewerShortfall; shortfall != nil {
+		result.ReviewerShortfall = &pb.ReviewerShortfall{
+			Required:   int32(shortfall.Required),
+			Assigned:   int32(shortfall.Assigned),
+			AtCapacity: int32(shortfall.AtCapacity),
+			Reason:     shortfall.Reason,
+		}
+	}
+	if sync := pr.ReviewersSync; sync != nil {
+		result.ReviewersSync = &pb.ReviewersSync{
+			Status:    sync.Status,
+			Error:     sync.Error,
+			UpdatedAt: timestamppb.New(sync.UpdatedAt),
+		}
+	}
+	return result
+}
@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/grpc/pb"
+	mock_pullrequest "github.com/Mockird31/avito_tech/mocks/pullrequest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_MergePullRequest(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *pb.MergePullRequestRequest
+		mockSetup func(m *mock_pullrequest.MockIUsecase)
+		wantCode  codes.Code
+		wantPr    *pb.PullRequest
+	}{
+		{
+			name:      "id_too_long",
+			req:       &pb.MergePullRequestRequest{PullRequestId: strings.Repeat("a", 65)},
+			mockSetup: func(m *mock_pullrequest.MockIUsecase) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "not_found",
+			req:  &pb.MergePullRequestRequest{PullRequestId: "pr-1"},
+			mockSetup: func(m *mock_pullrequest.MockIUsecase) {
+				m.EXPECT().
+					MergePullRequest(mock.Anything, &entity.PullRequest{Id: "pr-1"}).
+					Return(nil, entity.ErrPullRequestNotExist)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "success",
+			req:  &pb.MergePullRequestRequest{PullRequestId: "pr-1"},
+			mockSetup: func(m *mock_pullrequest.MockIUsecase) {
+				m.EXPECT().
+					MergePullRequest(mock.Anything, &entity.PullRequest{Id: "pr-1"}).
+					Return(&entity.PullRequest{
+						Id:                   "pr-1",
+						PrName:               "feature",
+						AuthorId:             "u1",
+						Status:               entity.StatusMerged.String(),
+						AssignedReviewersIds: []string{"u2"},
+						Version:              2,
+					}, nil)
+			},
+			wantCode: codes.OK,
+			wantPr: &pb.PullRequest{
+				PullRequestId:     "pr-1",
+				PullRequestName:   "feature",
+				AuthorId:          "u1",
+				Status:            "MERGED",
+				AssignedReviewers: []string{"u2"},
+				Version:           2,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock_pullrequest.NewMockIUsecase(t)
+			tt.mockSetup(m)
+
+			got, err := NewServer(m).MergePullRequest(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantPr != nil {
+				assert.Equal(t, tt.wantPr.GetPullRequestId(), got.GetPullRequestId())
+				assert.Equal(t, tt.wantPr.GetStatus(), got.GetStatus())
+				assert.Equal(t, tt.wantPr.GetAssignedReviewers(), got.GetAssignedReviewers())
+				assert.Equal(t, tt.wantPr.GetVersion(), got.GetVersion())
+				assert.Nil(t, got.GetMergedAt())
+			}
+		})
+	}
+}
+
+func TestServer_UpdatePullRequest_Labels(t *testing.T) {
+	tests := []struct {
+		name       string
+		labels     *pb.Labels
+		wantLabels []string
+	}{
+		{
+			name:       "labels_not_passed",
+			labels:     nil,
+			wantLabels: nil,
+		},
+		{
+			name:       "labels_cleared",
+			labels:     &pb.Labels{},
+			wantLabels: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock_pullrequest.NewMockIUsecase(t)
+			m.EXPECT().
+				UpdatePullRequest(mock.Anything, mock.MatchedBy(func(u *entity.PullRequestUpdate) bool {
+					return assert.ObjectsAreEqual(tt.wantLabels, u.Labels)
+				})).
+				Return(&entity.PullRequest{Id: "pr-1", Version: 3}, nil)
+
+			_, err := NewServer(m).UpdatePullRequest(context.Background(), &pb.UpdatePullRequestRequest{
+				PullRequestId: "pr-1",
+				Version:       2,
+				Labels:        tt.labels,
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestServer_ListPullRequests_InvalidCursor(t *testing.T) {
+	m := mock_pullrequest.NewMockIUsecase(t)
+
+	_, err := NewServer(m).ListPullRequests(context.Background(), &pb.ListPullRequestsRequest{
+		Filter: &pb.PullRequestFilter{Cursor: "!!!"},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
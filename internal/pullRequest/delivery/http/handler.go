@@ -1,12 +1,13 @@
 package http
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/asaskevich/govalidator"
 
 	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/httpquery"
 	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
 	json "github.com/Mockird31/avito_tech/pkg/json"
 )
@@ -45,16 +46,7 @@ func (h *Handler) CreatePullRequest(w http.ResponseWriter, r *http.Request) {
 
 	pullRequest, err := h.usecase.CreatePullRequest(ctx, &createPullRequest)
 	if err != nil {
-		var statusCode int
-		switch {
-		case errors.Is(err, entity.ErrAuthorOrTeamNotExist):
-			statusCode = http.StatusNotFound
-		case errors.Is(err, entity.ErrPullRequestExist):
-			statusCode = http.StatusConflict
-		default:
-			statusCode = http.StatusInternalServerError
-		}
-		json.WriteErrorJson(w, statusCode, err.Error())
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
 		return
 	}
 
@@ -85,14 +77,38 @@ func (h *Handler) MergePullRequest(w http.ResponseWriter, r *http.Request) {
 
 	pullRequest, err := h.usecase.MergePullRequest(ctx, &mergePullRequest)
 	if err != nil {
-		var statusCode int
-		switch {
-		case errors.Is(err, entity.ErrPullRequestNotExist):
-			statusCode = http.StatusNotFound
-		default:
-			statusCode = http.StatusInternalServerError
-		}
-		json.WriteErrorJson(w, statusCode, err.Error())
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestResponse{PullRequest: pullRequest}, nil)
+}
+
+func (h *Handler) UpdatePullRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var updatePullRequest entity.PullRequestUpdate
+
+	err := json.ReadJSON(w, r, &updatePullRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to parse request")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(updatePullRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	pullRequest, err := h.usecase.UpdatePullRequest(ctx, &updatePullRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
 		return
 	}
 
@@ -121,22 +137,131 @@ func (h *Handler) ReassignPullRequest(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pullRequest, newReviewer, err := h.usecase.ReassignPullRequest(ctx, &reassignPullRequest)
+	result, err := h.usecase.ReassignPullRequest(ctx, &reassignPullRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestReassignResponse{
+		PullRequest:        result.PullRequest,
+		ReplacedBy:         result.ReplacedBy,
+		Outcome:            result.Outcome,
+		ExcludedCandidates: result.ExcludedCandidates,
+	}, nil)
+}
+
+func (h *Handler) GetPullRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	prId := r.URL.Query().Get("pull_request_id")
+	if prId == "" {
+		json.WriteErrorJson(w, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	pullRequest, err := h.usecase.GetPullRequestById(ctx, prId)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestResponse{PullRequest: pullRequest}, nil)
+}
+
+func (h *Handler) ListPullRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	values := r.URL.Query()
+	filter, err := httpquery.PullRequestFilter(values)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.TeamName = values.Get("team_name")
+	filter.AuthorId = values.Get("author_id")
+	filter.ReviewerId = values.Get("reviewer_id")
+	filter.NameSearch = values.Get("name")
+
+	pullRequestList, err := h.usecase.ListPullRequests(ctx, filter)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestListResponse{PullRequestList: pullRequestList}, nil)
+}
+
+func (h *Handler) AddReviewer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var reviewerChange entity.PullRequestReviewerChange
+
+	err := json.ReadJSON(w, r, &reviewerChange)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to parse request")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(reviewerChange)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	pullRequest, err := h.usecase.AddReviewer(ctx, &reviewerChange)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestResponse{PullRequest: pullRequest}, nil)
+}
+
+func (h *Handler) RemoveReviewer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var reviewerChange entity.PullRequestReviewerChange
+
+	err := json.ReadJSON(w, r, &reviewerChange)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to parse request")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(reviewerChange)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	pullRequest, err := h.usecase.RemoveReviewer(ctx, &reviewerChange)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestResponse{PullRequest: pullRequest}, nil)
+}
+
+func (h *Handler) ReconcileReviewers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	result, err := h.usecase.ReconcileReviewers(ctx)
 	if err != nil {
-		var statusCode int
-		switch {
-		case errors.Is(err, entity.ErrPullRequestNotExist):
-			statusCode = http.StatusNotFound
-		case errors.Is(err, entity.ErrUserNotFound):
-			statusCode = http.StatusNotFound
-		case errors.Is(err, entity.ErrRequestAlreadyMerged):
-			statusCode = http.StatusConflict
-		default:
-			statusCode = http.StatusInternalServerError
-		}
-		json.WriteErrorJson(w, statusCode, err.Error())
+		json.WriteErrorJson(w, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	json.WriteJSON(w, http.StatusOK, &entity.PullRequestReassignResponse{PullRequest: pullRequest, ReplacedBy: newReviewer}, nil)
+	json.WriteJSON(w, http.StatusOK, &entity.ReconcileResponse{Reconcile: result}, nil)
 }
@@ -0,0 +1,189 @@
+package http
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/httpquery"
+	json "github.com/Mockird31/avito_tech/pkg/json"
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+)
+
+// Обработчики /api/v2: pull request адресуется идентификатором в пути, ошибки разбора тела - 400,
+// коды ошибок без исключений v1.
+
+func (h *Handler) CreatePullRequestV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var createPullRequest entity.PullRequest
+	if err := json.ReadJSON(w, r, &createPullRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if _, err := govalidator.ValidateStruct(createPullRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pullRequest, err := h.usecase.CreatePullRequest(ctx, &createPullRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	headers := http.Header{"Location": {"/api/v2/pull-requests/" + url.PathEscape(pullRequest.Id)}}
+	json.WriteJSON(w, http.StatusCreated, &entity.PullRequestResponse{PullRequest: pullRequest}, headers)
+}
+
+func (h *Handler) GetPullRequestV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	pullRequest, err := h.usecase.GetPullRequestById(ctx, mux.Vars(r)["id"])
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestResponse{PullRequest: pullRequest}, nil)
+}
+
+func (h *Handler) ListPullRequestsV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	values := r.URL.Query()
+	filter, err := httpquery.PullRequestFilter(values)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.TeamName = values.Get("team_name")
+	filter.AuthorId = values.Get("author_id")
+	filter.ReviewerId = values.Get("reviewer_id")
+	filter.NameSearch = values.Get("name")
+
+	pullRequestList, err := h.usecase.ListPullRequests(ctx, filter)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestListResponse{PullRequestList: pullRequestList}, nil)
+}
+
+func (h *Handler) PatchPullRequestV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var updatePullRequest entity.PullRequestUpdate
+	if err := json.ReadJSON(w, r, &updatePullRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+	updatePullRequest.Id = mux.Vars(r)["id"]
+
+	if _, err := govalidator.ValidateStruct(updatePullRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pullRequest, err := h.usecase.UpdatePullRequest(ctx, &updatePullRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestResponse{PullRequest: pullRequest}, nil)
+}
+
+func (h *Handler) MergePullRequestV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	mergePullRequest := entity.PullRequest{Id: mux.Vars(r)["id"]}
+	if _, err := govalidator.ValidateStruct(mergePullRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pullRequest, err := h.usecase.MergePullRequest(ctx, &mergePullRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestResponse{PullRequest: pullRequest}, nil)
+}
+
+func (h *Handler) ReassignPullRequestV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var reassignPullRequest entity.PullRequestReassignRequest
+	if err := json.ReadJSON(w, r, &reassignPullRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+	reassignPullRequest.Id = mux.Vars(r)["id"]
+
+	if _, err := govalidator.ValidateStruct(reassignPullRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	result, err := h.usecase.ReassignPullRequest(ctx, &reassignPullRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestReassignResponse{
+		PullRequest:        result.PullRequest,
+		ReplacedBy:         result.ReplacedBy,
+		Outcome:            result.Outcome,
+		ExcludedCandidates: result.ExcludedCandidates,
+	}, nil)
+}
+
+func (h *Handler) AddReviewerV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var reviewerChange entity.PullRequestReviewerChange
+	if err := json.ReadJSON(w, r, &reviewerChange); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+	reviewerChange.Id = mux.Vars(r)["id"]
+
+	if _, err := govalidator.ValidateStruct(reviewerChange); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pullRequest, err := h.usecase.AddReviewer(ctx, &reviewerChange)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestResponse{PullRequest: pullRequest}, nil)
+}
+
+func (h *Handler) RemoveReviewerV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	vars := mux.Vars(r)
+	reviewerChange := entity.PullRequestReviewerChange{Id: vars["id"], ReviewerId: vars["reviewer_id"]}
+	if _, err := govalidator.ValidateStruct(reviewerChange); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pullRequest, err := h.usecase.RemoveReviewer(ctx, &reviewerChange)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.PullRequestResponse{PullRequest: pullRequest}, nil)
+}
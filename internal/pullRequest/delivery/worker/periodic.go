@@ -0,0 +1,22 @@
+package worker
+
+import (
+	"context"
+	"time"
+)
+
+// runPeriodically вызывает job сразу и затем раз в interval, пока не отменен ctx.
+func runPeriodically(ctx context.Context, interval time.Duration, job func(ctx context.Context)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// Reconciler периодически добирает ревьюверов на открытые pull request'ы,
+// которым при создании не хватило кандидатов.
+type Reconciler struct {
+	usecase  pullrequest.IUsecase
+	interval time.Duration
+}
+
+func NewReconciler(usecase pullrequest.IUsecase, interval time.Duration) *Reconciler {
+	return &Reconciler{
+		usecase:  usecase,
+		interval: interval,
+	}
+}
+
+// Run выполняет сверку сразу и затем раз в interval, пока не отменен ctx.
+func (r *Reconciler) Run(ctx context.Context) {
+	runPeriodically(ctx, r.interval, r.reconcile)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	result, err := r.usecase.ReconcileReviewers(ctx)
+	if err != nil {
+		logger.Error("failed to reconcile reviewers (Reconciler)", zap.Error(err))
+		return
+	}
+	if result.Added > 0 {
+		logger.Info("reviewers topped up (Reconciler)", zap.Int("checked", result.Checked), zap.Int("added", result.Added))
+	}
+}
@@ -0,0 +1,41 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// SlaScheduler периодически помечает ревью, просроченные по SLA команды, и переназначает их, если команда это включила.
+type SlaScheduler struct {
+	usecase  pullrequest.IUsecase
+	interval time.Duration
+}
+
+func NewSlaScheduler(usecase pullrequest.IUsecase, interval time.Duration) *SlaScheduler {
+	return &SlaScheduler{
+		usecase:  usecase,
+		interval: interval,
+	}
+}
+
+// Run выполняет проверку сразу и затем раз в interval, пока не отменен ctx.
+func (s *SlaScheduler) Run(ctx context.Context) {
+	runPeriodically(ctx, s.interval, s.escalate)
+}
+
+func (s *SlaScheduler) escalate(ctx context.Context) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	result, err := s.usecase.EscalateOverdueReviews(ctx)
+	if err != nil {
+		logger.Error("failed to escalate overdue reviews (SlaScheduler)", zap.Error(err))
+		return
+	}
+	if result.Marked > 0 {
+		logger.Info("overdue reviews escalated (SlaScheduler)", zap.Int("marked", result.Marked), zap.Int("reassigned", result.Reassigned))
+	}
+}
@@ -7,14 +7,35 @@ import (
 )
 
 type IRepository interface {
-	CreatePullRequest(ctx context.Context, prId string, prName string, authorId string) error
+	CreatePullRequest(ctx context.Context, pullRequest *entity.PullRequest) error
 	CheckPullRequestExistById(ctx context.Context, prId string) (bool, error)
 	ConnectReviewersWithPullRequest(ctx context.Context, prId string, reviewersIds []string) error
 	GetPullRequestById(ctx context.Context, prId string) (*entity.PullRequest, error)
 	GetReviewersByPrId(ctx context.Context, prId string) ([]string, error)
 	MergePullRequest(ctx context.Context, prId string) error
+	ClosePullRequest(ctx context.Context, prId string) error
+	ReopenPullRequest(ctx context.Context, prId string) error
 	CheckPullRequestIsMergedById(ctx context.Context, prId string) (bool, error)
 	GetAuthorIdByPRId(ctx context.Context, oldReviewerId string) (string, error)
 	UpdateReviewerId(ctx context.Context, prId string, oldReviewerId string, newReviewerId string) error
-	GetPullRequestsByReviewerId(ctx context.Context, reviewerId string) ([]*entity.PullRequestShort, error)
+	GetPullRequestsByReviewerId(ctx context.Context, filter *entity.PullRequestFilter) ([]*entity.PullRequestShort, error)
+	CountPullRequestsByReviewerId(ctx context.Context, filter *entity.PullRequestFilter) (int, error)
+	GetPullRequestsByAuthorId(ctx context.Context, filter *entity.PullRequestFilter) ([]*entity.PullRequestShort, error)
+	CountPullRequestsByAuthorId(ctx context.Context, filter *entity.PullRequestFilter) (int, error)
+	RemoveReviewer(ctx context.Context, prId string, reviewerId string) error
+	GetReviewerLimitsForUpdate(ctx context.Context, prId string) (*entity.TeamReviewerLimits, error)
+	RecordEvent(ctx context.Context, event *entity.PullRequestEvent) error
+	UpdatePullRequest(ctx context.Context, update *entity.PullRequestUpdate) error
+	ListPullRequests(ctx context.Context, filter *entity.PullRequestFilter) ([]*entity.PullRequestShort, error)
+	CountPullRequests(ctx context.Context, filter *entity.PullRequestFilter) (int, error)
+	ExportPullRequests(ctx context.Context, afterId string, limit int) ([]*entity.PullRequest, error)
+	GetReviewersByPrIds(ctx context.Context, prIds []string) (map[string][]*entity.PullRequestReviewer, error)
+	GetOpenReviewAssignmentsByTeam(ctx context.Context, teamName string) ([]*entity.ReviewAssignment, error)
+	GetOpenReviewAssignmentsByReviewers(ctx context.Context, reviewerIds []string) ([]*entity.ReviewAssignment, error)
+	UpdateReviewersBatch(ctx context.Context, moves []*entity.ReviewerMove) error
+	MarkOverdueReviews(ctx context.Context) ([]*entity.OverdueReview, error)
+	GetUnderReviewedPullRequests(ctx context.Context, required int) ([]*entity.UnderReviewedPullRequest, error)
+	LockPullRequestForTopUp(ctx context.Context, prId string) (bool, error)
+	SetReviewerSyncStatus(ctx context.Context, prId string, reviewerId string, status string, syncError string) error
+	ClearReviewerSyncStatus(ctx context.Context, prId string, reviewerId string) error
 }
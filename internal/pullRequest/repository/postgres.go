@@ -10,6 +10,8 @@ import (
 	"github.com/Mockird31/avito_tech/internal/entity"
 	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
 	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -20,10 +22,40 @@ const (
 		WHERE id = $1;
 	`
 	GetPullRequestByIdQuery = `
-		SELECT id, name, author_id, status, merged_at
+		SELECT id, name, author_id, status, description, labels, priority, version, merged_at,
+			reviewers_sync_status, reviewers_sync_error, reviewers_sync_updated_at
 		FROM pull_request
 		WHERE id = $1;
 	`
+	// SetReviewerSyncStatusQuery: изменение строки ревьювера в CTE не видно остальной части запроса,
+	// поэтому его новое состояние берется из RETURNING, а состояния остальных ревьюверов - из таблицы.
+	SetReviewerSyncStatusQuery = `
+		WITH updated AS (
+			UPDATE pull_request_reviewers
+			SET sync_status = $3,
+				sync_error = $4
+			WHERE pull_request_id = $1 AND reviewer_id = $2
+			RETURNING reviewer_id, sync_status, sync_error
+		), reviewers AS (
+			SELECT reviewer_id, sync_status, sync_error FROM updated
+			UNION ALL
+			SELECT reviewer_id, sync_status, sync_error
+			FROM pull_request_reviewers
+			WHERE pull_request_id = $1 AND reviewer_id <> $2
+		)
+		UPDATE pull_request
+		SET reviewers_sync_status = CASE
+				WHEN EXISTS (SELECT 1 FROM reviewers WHERE sync_status = 'failed') THEN 'failed'
+				WHEN NOT EXISTS (SELECT 1 FROM reviewers WHERE sync_status IS DISTINCT FROM 'synced') THEN 'synced'
+			END,
+			reviewers_sync_error = COALESCE((
+				SELECT STRING_AGG(sync_error, '; ' ORDER BY reviewer_id)
+				FROM reviewers
+				WHERE sync_status = 'failed'
+			), ''),
+			reviewers_sync_updated_at = NOW()
+		WHERE id = $1;
+	`
 	GetReviewersByPrId = `
 		SELECT u.id
 		FROM "user" u
@@ -32,14 +64,54 @@ const (
 	`
 	CreatePullRequestQuery = `
 		INSERT INTO pull_request
-		(id, name, author_id, status)
-		VALUES ($1, $2, $3, $4);
+		(id, name, author_id, status, description, labels, priority)
+		VALUES ($1, $2, $3, $4, $5, $6, $7);
 	`
 	MergePullRequestQuery = `
 		UPDATE pull_request
-		SET status = 'MERGED', merged_at = NOW()
+		SET status = 'MERGED', merged_at = NOW(), version = version + 1, updated_at = NOW()
 		WHERE id = $1;
 	`
+	// ClosePullRequestQuery и ReopenPullRequestQuery меняют статус только из ожидаемого,
+	// поэтому повторная доставка события из хостинга не меняет строку.
+	ClosePullRequestQuery = `
+		UPDATE pull_request
+		SET status = 'CLOSED', version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND status = 'OPEN';
+	`
+	ReopenPullRequestQuery = `
+		UPDATE pull_request
+		SET status = 'OPEN', version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND status = 'CLOSED';
+	`
+	RemoveReviewerQuery = `
+		DELETE FROM pull_request_reviewers
+		WHERE pull_request_id = $1 AND reviewer_id = $2;
+	`
+	// GetReviewerLimitsForUpdateQuery блокирует строку pull request'а до конца транзакции,
+	// чтобы параллельные изменения ревьюверов проверяли лимиты по актуальному составу.
+	GetReviewerLimitsForUpdateQuery = `
+		SELECT t.name, t.min_reviewers, t.max_reviewers
+		FROM pull_request pr
+		JOIN "user" u ON u.id = pr.author_id
+		JOIN team t ON t.name = u.team_name
+		WHERE pr.id = $1
+		FOR UPDATE OF pr;
+	`
+	RecordEventQuery = `
+		INSERT INTO pull_request_event (pull_request_id, event_type, reviewer_id)
+		VALUES ($1, $2, NULLIF($3, ''));
+	`
+	UpdatePullRequestQuery = `
+		UPDATE pull_request
+		SET name = COALESCE($1, name),
+		    description = COALESCE($2, description),
+		    labels = COALESCE($3::text[], labels),
+		    priority = COALESCE($4, priority),
+		    version = version + 1,
+		    updated_at = NOW()
+		WHERE id = $5 AND version = $6;
+	`
 	CheckPullRequestIsMergedByIdQuery = `
 		SELECT status
 		FROM pull_request
@@ -52,18 +124,197 @@ const (
     `
 	UpdateReviewerIdQuery = `
 		UPDATE pull_request_reviewers
-		SET reviewer_id = $1, updated_at = NOW()
+		SET reviewer_id = $1, created_at = NOW(), updated_at = NOW(), overdue_at = NULL
 		WHERE pull_request_id = $2 AND reviewer_id = $3;
 	`
+	// PullRequestFilterCondition - общие фильтры списков pull request'ов по статусу и датам ($2..$6),
+	// пустой статус и NULL-границы не ограничивают выборку.
+	PullRequestFilterCondition = `
+          AND ($2 = '' OR p.status = $2)
+          AND ($3::timestamptz IS NULL OR p.created_at >= $3)
+          AND ($4::timestamptz IS NULL OR p.created_at < $4)
+          AND ($5::timestamptz IS NULL OR p.merged_at >= $5)
+          AND ($6::timestamptz IS NULL OR p.merged_at < $6)`
 	GetPullRequestsByReviewerIdQuery = `
-        SELECT p.id, p.name, p.author_id, p.status, p.merged_at
+        SELECT p.id, p.name, p.author_id, p.status, p.created_at, p.merged_at
+        FROM pull_request p
+        JOIN pull_request_reviewers prr ON prr.pull_request_id = p.id
+        WHERE prr.reviewer_id = $1` + PullRequestFilterCondition + `
+          AND ($7::timestamptz IS NULL OR (p.created_at, p.id) < ($7, $8::text))
+        ORDER BY p.created_at DESC, p.id DESC
+        LIMIT $9;
+    `
+	GetPullRequestsByReviewerIdAscQuery = `
+        SELECT p.id, p.name, p.author_id, p.status, p.created_at, p.merged_at
+        FROM pull_request p
+        JOIN pull_request_reviewers prr ON prr.pull_request_id = p.id
+        WHERE prr.reviewer_id = $1` + PullRequestFilterCondition + `
+          AND ($7::timestamptz IS NULL OR (p.created_at, p.id) > ($7, $8::text))
+        ORDER BY p.created_at, p.id
+        LIMIT $9;
+    `
+	GetPullRequestsByAuthorIdQuery = `
+        SELECT p.id, p.name, p.author_id, p.status, p.created_at, p.merged_at
+        FROM pull_request p
+        WHERE p.author_id = $1` + PullRequestFilterCondition + `
+          AND ($7::timestamptz IS NULL OR (p.created_at, p.id) < ($7, $8::text))
+        ORDER BY p.created_at DESC, p.id DESC
+        LIMIT $9;
+    `
+	GetPullRequestsByAuthorIdAscQuery = `
+        SELECT p.id, p.name, p.author_id, p.status, p.created_at, p.merged_at
+        FROM pull_request p
+        WHERE p.author_id = $1` + PullRequestFilterCondition + `
+          AND ($7::timestamptz IS NULL OR (p.created_at, p.id) > ($7, $8::text))
+        ORDER BY p.created_at, p.id
+        LIMIT $9;
+    `
+	CountPullRequestsByAuthorIdQuery = `
+        SELECT COUNT(*)
+        FROM pull_request p
+        WHERE p.author_id = $1` + PullRequestFilterCondition + `;
+    `
+	// PullRequestListCondition - фильтры /pullRequest/list по команде автора, ревьюверу и подстроке
+	// в названии ($7..$9), поиск по названию использует триграммный индекс
+	PullRequestListCondition = `
+          AND ($7 = '' OR a.team_name = $7)
+          AND ($8 = '' OR EXISTS (
+              SELECT 1
+              FROM pull_request_reviewers prr
+              WHERE prr.pull_request_id = p.id AND prr.reviewer_id = $8
+          ))
+          AND ($9 = '' OR p.name ILIKE '%' || $9 || '%')`
+	ListPullRequestsQuery = `
+        SELECT p.id, p.name, p.author_id, p.status, p.created_at, p.merged_at
+        FROM pull_request p
+        JOIN "user" a ON a.id = p.author_id
+        WHERE ($1 = '' OR p.author_id = $1)` + PullRequestFilterCondition + PullRequestListCondition + `
+          AND ($10::timestamptz IS NULL OR (p.created_at, p.id) < ($10, $11::text))
+        ORDER BY p.created_at DESC, p.id DESC
+        LIMIT $12;
+    `
+	ListPullRequestsAscQuery = `
+        SELECT p.id, p.name, p.author_id, p.status, p.created_at, p.merged_at
+        FROM pull_request p
+        JOIN "user" a ON a.id = p.author_id
+        WHERE ($1 = '' OR p.author_id = $1)` + PullRequestFilterCondition + PullRequestListCondition + `
+          AND ($10::timestamptz IS NULL OR (p.created_at, p.id) > ($10, $11::text))
+        ORDER BY p.created_at, p.id
+        LIMIT $12;
+    `
+	CountPullRequestsQuery = `
+        SELECT COUNT(*)
+        FROM pull_request p
+        JOIN "user" a ON a.id = p.author_id
+        WHERE ($1 = '' OR p.author_id = $1)` + PullRequestFilterCondition + PullRequestListCondition + `;
+    `
+	// ExportPullRequestsQuery - страница pull request'ов по возрастанию id вместе с ревьюверами, для выгрузки
+	// одним запросом на страницу.
+	ExportPullRequestsQuery = `
+        SELECT p.id, p.name, p.author_id, p.status, p.description, p.labels, p.priority, p.version, p.merged_at,
+            p.reviewers_sync_status, p.reviewers_sync_error, p.reviewers_sync_updated_at,
+            ARRAY(
+                SELECT prr.reviewer_id
+                FROM pull_request_reviewers prr
+                WHERE prr.pull_request_id = p.id
+                ORDER BY prr.created_at, prr.reviewer_id
+            )
+        FROM pull_request p
+        WHERE p.id > $1
+        ORDER BY p.id
+        LIMIT $2;
+    `
+	GetReviewersByPrIdsQuery = `
+        SELECT prr.pull_request_id, u.id, u.username, u.is_active, prr.created_at
+        FROM pull_request_reviewers prr
+        JOIN "user" u ON u.id = prr.reviewer_id
+        WHERE prr.pull_request_id = ANY($1)
+        ORDER BY prr.pull_request_id, prr.created_at, u.id;
+    `
+	CountPullRequestsByReviewerIdQuery = `
+        SELECT COUNT(*)
+        FROM pull_request p
+        JOIN pull_request_reviewers prr ON prr.pull_request_id = p.id
+        WHERE prr.reviewer_id = $1` + PullRequestFilterCondition + `;
+    `
+	// MarkOverdueReviewsQuery помечает назначения на открытые pull request'ы, которые дольше SLA команды автора
+	// остаются без ревью, и возвращает только что помеченные.
+	MarkOverdueReviewsQuery = `
+        UPDATE pull_request_reviewers prr
+        SET overdue_at = NOW()
+        FROM pull_request p
+        JOIN "user" a ON a.id = p.author_id
+        JOIN team t ON t.name = a.team_name
+        WHERE p.id = prr.pull_request_id
+          AND p.status = 'OPEN'
+          AND prr.overdue_at IS NULL
+          AND t.review_sla_hours IS NOT NULL
+          AND prr.created_at < NOW() - make_interval(hours => t.review_sla_hours)
+        RETURNING prr.pull_request_id, prr.reviewer_id, t.name, prr.created_at, prr.overdue_at, t.review_sla_hours, t.review_sla_auto_reassign;
+    `
+	// pull request'ы, с которых ревьювера сняли вручную (событие reviewer_removed), не добираются:
+	// сверка не должна отменять осознанное решение и возвращать того же ревьювера
+	GetUnderReviewedPullRequestsQuery = `
+        SELECT p.id, p.author_id, COUNT(prr.reviewer_id)
+        FROM pull_request p
+        LEFT JOIN pull_request_reviewers prr ON prr.pull_request_id = p.id
+        WHERE p.status = 'OPEN'
+          AND NOT EXISTS (
+              SELECT 1
+              FROM pull_request_event e
+              WHERE e.pull_request_id = p.id AND e.event_type = 'reviewer_removed'
+          )
+        GROUP BY p.id, p.author_id, p.created_at
+        HAVING COUNT(prr.reviewer_id) < $1
+        ORDER BY p.created_at, p.id;
+    `
+	// LockPullRequestForTopUpQuery не ждет чужих блокировок: pull request, который сейчас меняет
+	// другая реплика или ручной запрос, пропускается до следующей сверки
+	LockPullRequestForTopUpQuery = `
+        SELECT p.id
+        FROM pull_request p
+        WHERE p.id = $1 AND p.status = 'OPEN'
+          AND NOT EXISTS (
+              SELECT 1
+              FROM pull_request_event e
+              WHERE e.pull_request_id = p.id AND e.event_type = 'reviewer_removed'
+          )
+        FOR UPDATE OF p SKIP LOCKED;
+    `
+	GetOpenReviewAssignmentsByTeamQuery = `
+        SELECT p.id, p.author_id, prr.reviewer_id, a.team_name
+        FROM pull_request p
+        JOIN pull_request_reviewers prr ON prr.pull_request_id = p.id
+        JOIN "user" a ON a.id = p.author_id
+        WHERE p.status = 'OPEN' AND a.team_name = $1
+        ORDER BY p.created_at, p.id;
+    `
+	// GetOpenReviewAssignmentsByReviewersQuery блокирует найденные pull request'ы до конца транзакции
+	// (в одном порядке для всех вызовов), чтобы деактивация применяла замены к тому составу, по которому их считала.
+	GetOpenReviewAssignmentsByReviewersQuery = `
+        SELECT p.id, p.author_id, prr.reviewer_id, a.team_name
         FROM pull_request p
         JOIN pull_request_reviewers prr ON prr.pull_request_id = p.id
-        WHERE prr.reviewer_id = $1
-        ORDER BY p.created_at DESC;
+        JOIN "user" a ON a.id = p.author_id
+        WHERE p.status = 'OPEN'
+          AND p.id IN (
+              SELECT pull_request_id
+              FROM pull_request_reviewers
+              WHERE reviewer_id = ANY($1)
+          )
+        ORDER BY p.created_at, p.id, prr.reviewer_id
+        FOR UPDATE OF p;
+    `
+	UpdateReviewersBatchQuery = `
+        UPDATE pull_request_reviewers prr
+        SET reviewer_id = m.new_reviewer_id, created_at = NOW(), updated_at = NOW(), overdue_at = NULL
+        FROM unnest($1::text[], $2::text[], $3::text[]) AS m(pull_request_id, old_reviewer_id, new_reviewer_id)
+        WHERE prr.pull_request_id = m.pull_request_id AND prr.reviewer_id = m.old_reviewer_id;
     `
 )
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 type repository struct {
 	db *sql.DB
 }
@@ -79,7 +330,7 @@ func (r *repository) CheckPullRequestExistById(ctx context.Context, prId string)
 
 	var isExist bool
 
-	err := r.db.QueryRowContext(ctx, CheckPullRequestExistByIdQuery, prId).Scan(&isExist)
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, CheckPullRequestExistByIdQuery, prId).Scan(&isExist)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("pull request not found by id", zap.String("pr_id", prId))
@@ -94,7 +345,7 @@ func (r *repository) CheckPullRequestExistById(ctx context.Context, prId string)
 func (r *repository) GetReviewersByPrId(ctx context.Context, prId string) ([]string, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
-	rows, err := r.db.QueryContext(ctx, GetReviewersByPrId, prId)
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetReviewersByPrId, prId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("no reviewers to pr", "pr_id", prId)
@@ -136,9 +387,29 @@ func (r *repository) GetPullRequestById(ctx context.Context, prId string) (*enti
 
 	var pullRequest entity.PullRequest
 	var mergedAt sql.NullTime
+	var syncStatus sql.NullString
+	var syncError string
+	var syncUpdatedAt sql.NullTime
 
-	err := r.db.QueryRowContext(ctx, GetPullRequestByIdQuery, prId).Scan(&pullRequest.Id, &pullRequest.PrName, &pullRequest.AuthorId, &pullRequest.Status, &mergedAt)
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, GetPullRequestByIdQuery, prId).Scan(
+		&pullRequest.Id,
+		&pullRequest.PrName,
+		&pullRequest.AuthorId,
+		&pullRequest.Status,
+		&pullRequest.Description,
+		pq.Array(&pullRequest.Labels),
+		&pullRequest.Priority,
+		&pullRequest.Version,
+		&mergedAt,
+		&syncStatus,
+		&syncError,
+		&syncUpdatedAt,
+	)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Info("pull request not found (GetPullRequestById)", zap.String("pr_id", prId))
+			return nil, entity.ErrPullRequestNotExist
+		}
 		logger.Error("failed to get pull request by id", "id", prId, "error", zap.Error(err))
 		return nil, err
 	}
@@ -149,15 +420,52 @@ func (r *repository) GetPullRequestById(ctx context.Context, prId string) (*enti
 		pullRequest.MergedAt = nil
 	}
 
+	if syncStatus.Valid {
+		pullRequest.ReviewersSync = &entity.ReviewersSync{
+			Status:    syncStatus.String,
+			Error:     syncError,
+			UpdatedAt: syncUpdatedAt.Time,
+		}
+	}
+
 	return &pullRequest, nil
 }
 
-func (r *repository) CreatePullRequest(ctx context.Context, prId string, prName string, authorId string) error {
+// SetReviewerSyncStatus записывает результат синхронизации одного ревьювера и пересчитывает статус pull request'а:
+// failed, если не удалась синхронизация хотя бы одного текущего ревьювера, synced - только когда синхронизированы все,
+// иначе статуса нет. Ревьювер, уже снятый с pull request'а, на статус не влияет.
+func (r *repository) SetReviewerSyncStatus(ctx context.Context, prId string, reviewerId string, status string, syncError string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, SetReviewerSyncStatusQuery, prId, reviewerId, status, syncError)
+	if err != nil {
+		logger.Error("failed to set reviewer sync status (SetReviewerSyncStatus)", zap.Error(err), zap.String("pr_id", prId), zap.String("reviewer_id", reviewerId), zap.String("status", status))
+		return err
+	}
+	return nil
+}
+
+// ClearReviewerSyncStatus убирает результат синхронизации ревьювера и пересчитывает статус pull request'а
+// тем же запросом, что SetReviewerSyncStatus. Снятого ревьювера в таблице уже нет, и статус считается по оставшимся.
+func (r *repository) ClearReviewerSyncStatus(ctx context.Context, prId string, reviewerId string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, SetReviewerSyncStatusQuery, prId, reviewerId, nil, "")
+	if err != nil {
+		logger.Error("failed to clear reviewer sync status (ClearReviewerSyncStatus)", zap.Error(err), zap.String("pr_id", prId), zap.String("reviewer_id", reviewerId))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) CreatePullRequest(ctx context.Context, pullRequest *entity.PullRequest) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
-	_, err := r.db.ExecContext(ctx, CreatePullRequestQuery, prId, prName, authorId, entity.StatusOpen)
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, CreatePullRequestQuery,
+		pullRequest.Id, pullRequest.PrName, pullRequest.AuthorId, entity.StatusOpen,
+		pullRequest.Description, pq.Array(pullRequest.Labels), pullRequest.Priority)
 	if err != nil {
-		logger.Error("failed to create pull request", "pr_id", prId, "error", zap.Error(err))
+		logger.Error("failed to create pull request", "pr_id", pullRequest.Id, "error", zap.Error(err))
 		return err
 	}
 	return nil
@@ -208,7 +516,7 @@ func (r *repository) ConnectReviewersWithPullRequest(ctx context.Context, prId s
 		return err
 	}
 
-	_, err = r.db.ExecContext(ctx, query, args...)
+	_, err = postgres.Conn(ctx, r.db).ExecContext(ctx, query, args...)
 	if err != nil {
 		logger.Error("failed to connect reviewers with pull request (ConnectReviewersWithPullRequest)", "pr_id", prId, "error", zap.Error(err))
 		return err
@@ -220,7 +528,7 @@ func (r *repository) ConnectReviewersWithPullRequest(ctx context.Context, prId s
 func (r *repository) MergePullRequest(ctx context.Context, prId string) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
-	_, err := r.db.ExecContext(ctx, MergePullRequestQuery, prId)
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, MergePullRequestQuery, prId)
 	if err != nil {
 		logger.Error("failed to merge pull request (MergePullRequest)", zap.Error(err), zap.String("pr_id", prId))
 		return err
@@ -228,12 +536,121 @@ func (r *repository) MergePullRequest(ctx context.Context, prId string) error {
 	return nil
 }
 
+func (r *repository) ClosePullRequest(ctx context.Context, prId string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	res, err := postgres.Conn(ctx, r.db).ExecContext(ctx, ClosePullRequestQuery, prId)
+	if err != nil {
+		logger.Error("failed to close pull request (ClosePullRequest)", zap.Error(err), zap.String("pr_id", prId))
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.Error("failed to get rows affected (ClosePullRequest)", zap.Error(err))
+		return err
+	}
+
+	if affected == 0 {
+		logger.Info("pull request is not open (ClosePullRequest)", zap.String("pr_id", prId))
+		return entity.ErrPullRequestNotOpen
+	}
+	return nil
+}
+
+func (r *repository) ReopenPullRequest(ctx context.Context, prId string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	res, err := postgres.Conn(ctx, r.db).ExecContext(ctx, ReopenPullRequestQuery, prId)
+	if err != nil {
+		logger.Error("failed to reopen pull request (ReopenPullRequest)", zap.Error(err), zap.String("pr_id", prId))
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.Error("failed to get rows affected (ReopenPullRequest)", zap.Error(err))
+		return err
+	}
+
+	if affected == 0 {
+		logger.Info("pull request is not closed (ReopenPullRequest)", zap.String("pr_id", prId))
+		return entity.ErrPullRequestNotClosed
+	}
+	return nil
+}
+
+func (r *repository) RemoveReviewer(ctx context.Context, prId string, reviewerId string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, RemoveReviewerQuery, prId, reviewerId)
+	if err != nil {
+		logger.Error("failed to remove reviewer (RemoveReviewer)", zap.Error(err), zap.String("pr_id", prId), zap.String("reviewer_id", reviewerId))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) GetReviewerLimitsForUpdate(ctx context.Context, prId string) (*entity.TeamReviewerLimits, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var limits entity.TeamReviewerLimits
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, GetReviewerLimitsForUpdateQuery, prId).Scan(&limits.TeamName, &limits.MinReviewers, &limits.MaxReviewers)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, entity.ErrPullRequestNotExist
+		}
+		logger.Error("failed to get reviewer limits (GetReviewerLimitsForUpdate)", zap.Error(err), zap.String("pr_id", prId))
+		return nil, err
+	}
+	return &limits, nil
+}
+
+func (r *repository) RecordEvent(ctx context.Context, event *entity.PullRequestEvent) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, RecordEventQuery, event.PullRequestId, event.EventType, event.ReviewerId)
+	if err != nil {
+		logger.Error("failed to record pull request event (RecordEvent)", zap.Error(err), zap.String("pr_id", event.PullRequestId), zap.String("event_type", event.EventType))
+		return err
+	}
+	return nil
+}
+
+// UpdatePullRequest возвращает entity.ErrVersionConflict, если версия pull request'а уже изменилась.
+func (r *repository) UpdatePullRequest(ctx context.Context, update *entity.PullRequestUpdate) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var labels any
+	if update.Labels != nil {
+		labels = pq.Array(update.Labels)
+	}
+
+	res, err := postgres.Conn(ctx, r.db).ExecContext(ctx, UpdatePullRequestQuery, update.PrName, update.Description, labels, update.Priority, update.Id, update.Version)
+	if err != nil {
+		logger.Error("failed to update pull request (UpdatePullRequest)", zap.Error(err), zap.String("pr_id", update.Id))
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.Error("failed to get rows affected (UpdatePullRequest)", zap.Error(err))
+		return err
+	}
+
+	if affected == 0 {
+		logger.Info("pull request version mismatch (UpdatePullRequest)", zap.String("pr_id", update.Id), zap.Int("version", update.Version))
+		return entity.ErrVersionConflict
+	}
+	return nil
+}
+
 func (r *repository) CheckPullRequestIsMergedById(ctx context.Context, prId string) (bool, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
 	var status string
 
-	err := r.db.QueryRowContext(ctx, CheckPullRequestIsMergedByIdQuery, prId).Scan(&status)
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, CheckPullRequestIsMergedByIdQuery, prId).Scan(&status)
 	if err != nil {
 		logger.Error("failed to get merged info about pr", zap.Error(err), zap.String("pr_id", prId))
 		return false, err
@@ -250,7 +667,7 @@ func (r *repository) GetAuthorIdByPRId(ctx context.Context, prId string) (string
 	logger := loggerPkg.LoggerFromContext(ctx)
 
 	var authorId string
-	err := r.db.QueryRowContext(ctx, GetAuthorIdByPRIdQuery, prId).Scan(&authorId)
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, GetAuthorIdByPRIdQuery, prId).Scan(&authorId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("author not found (GetAuthorIdByPRId)", zap.String("pr_id", prId))
@@ -265,7 +682,7 @@ func (r *repository) GetAuthorIdByPRId(ctx context.Context, prId string) (string
 func (r *repository) UpdateReviewerId(ctx context.Context, prId string, oldReviewerId string, newReviewerId string) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
-	_, err := r.db.ExecContext(ctx, UpdateReviewerIdQuery, newReviewerId, prId, oldReviewerId)
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, UpdateReviewerIdQuery, newReviewerId, prId, oldReviewerId)
 	if err != nil {
 		logger.Error("failed to update reviewer", zap.String("pr_id", prId), zap.String("old_reviewer", oldReviewerId), zap.String("new_reviewer", newReviewerId))
 		return err
@@ -274,10 +691,31 @@ func (r *repository) UpdateReviewerId(ctx context.Context, prId string, oldRevie
 	return nil
 }
 
-func (r *repository) GetPullRequestsByReviewerId(ctx context.Context, reviewerId string) ([]*entity.PullRequestShort, error) {
+func pullRequestFilterArgs(filter *entity.PullRequestFilter) []any {
+	return []any{filter.Status, filter.CreatedFrom, filter.CreatedTo, filter.MergedFrom, filter.MergedTo}
+}
+
+func pullRequestCursorArgs(filter *entity.PullRequestFilter) []any {
+	if filter.Cursor == nil {
+		return []any{nil, ""}
+	}
+	return []any{filter.Cursor.CreatedAt, filter.Cursor.Id}
+}
+
+func (r *repository) GetPullRequestsByReviewerId(ctx context.Context, filter *entity.PullRequestFilter) ([]*entity.PullRequestShort, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
+	reviewerId := filter.ReviewerId
+
+	query := GetPullRequestsByReviewerIdQuery
+	if filter.Order == entity.SortOrderAsc {
+		query = GetPullRequestsByReviewerIdAscQuery
+	}
 
-	rows, err := r.db.QueryContext(ctx, GetPullRequestsByReviewerIdQuery, reviewerId)
+	args := append([]any{reviewerId}, pullRequestFilterArgs(filter)...)
+	args = append(args, pullRequestCursorArgs(filter)...)
+	args = append(args, filter.Limit)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("pr's by reviewer_id not found", zap.String("reviewer_id", reviewerId))
@@ -287,6 +725,90 @@ func (r *repository) GetPullRequestsByReviewerId(ctx context.Context, reviewerId
 		return nil, err
 	}
 
+	return scanPullRequestsShort(ctx, rows)
+}
+
+func (r *repository) GetPullRequestsByAuthorId(ctx context.Context, filter *entity.PullRequestFilter) ([]*entity.PullRequestShort, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	query := GetPullRequestsByAuthorIdQuery
+	if filter.Order == entity.SortOrderAsc {
+		query = GetPullRequestsByAuthorIdAscQuery
+	}
+
+	args := append([]any{filter.AuthorId}, pullRequestFilterArgs(filter)...)
+	args = append(args, pullRequestCursorArgs(filter)...)
+	args = append(args, filter.Limit)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("failed to get PRs by author (GetPullRequestsByAuthorId)", zap.String("author_id", filter.AuthorId), zap.Error(err))
+		return nil, err
+	}
+
+	return scanPullRequestsShort(ctx, rows)
+}
+
+func (r *repository) CountPullRequestsByAuthorId(ctx context.Context, filter *entity.PullRequestFilter) (int, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	args := append([]any{filter.AuthorId}, pullRequestFilterArgs(filter)...)
+
+	var total int
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, CountPullRequestsByAuthorIdQuery, args...).Scan(&total)
+	if err != nil {
+		logger.Error("failed to count PRs by author (CountPullRequestsByAuthorId)", zap.String("author_id", filter.AuthorId), zap.Error(err))
+		return 0, err
+	}
+	return total, nil
+}
+
+func pullRequestListArgs(filter *entity.PullRequestFilter) []any {
+	args := append([]any{filter.AuthorId}, pullRequestFilterArgs(filter)...)
+	return append(args, filter.TeamName, filter.ReviewerId, likeEscaper.Replace(filter.NameSearch))
+}
+
+func (r *repository) ListPullRequests(ctx context.Context, filter *entity.PullRequestFilter) ([]*entity.PullRequestShort, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	query := ListPullRequestsQuery
+	if filter.Order == entity.SortOrderAsc {
+		query = ListPullRequestsAscQuery
+	}
+
+	args := append(pullRequestListArgs(filter), pullRequestCursorArgs(filter)...)
+	args = append(args, filter.Limit)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		logger.Error("failed to list PRs (ListPullRequests)", zap.Error(err))
+		return nil, err
+	}
+
+	return scanPullRequestsShort(ctx, rows)
+}
+
+func (r *repository) CountPullRequests(ctx context.Context, filter *entity.PullRequestFilter) (int, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var total int
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, CountPullRequestsQuery, pullRequestListArgs(filter)...).Scan(&total)
+	if err != nil {
+		logger.Error("failed to count PRs (CountPullRequests)", zap.Error(err))
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *repository) ExportPullRequests(ctx context.Context, afterId string, limit int) (pullRequests []*entity.PullRequest, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, ExportPullRequestsQuery, afterId, limit)
+	if err != nil {
+		logger.Error("failed to export PRs (ExportPullRequests)", zap.Error(err), zap.String("after_id", afterId))
+		return nil, err
+	}
+
 	defer func() {
 		closeErr := rows.Close()
 		if closeErr != nil && err == nil {
@@ -295,13 +817,110 @@ func (r *repository) GetPullRequestsByReviewerId(ctx context.Context, reviewerId
 		}
 	}()
 
-	pullRequests := make([]*entity.PullRequestShort, 0)
+	pullRequests = make([]*entity.PullRequest, 0)
+	for rows.Next() {
+		var pullRequest entity.PullRequest
+		var mergedAt sql.NullTime
+		var syncStatus sql.NullString
+		var syncError string
+		var syncUpdatedAt sql.NullTime
+		if err := rows.Scan(
+			&pullRequest.Id,
+			&pullRequest.PrName,
+			&pullRequest.AuthorId,
+			&pullRequest.Status,
+			&pullRequest.Description,
+			pq.Array(&pullRequest.Labels),
+			&pullRequest.Priority,
+			&pullRequest.Version,
+			&mergedAt,
+			&syncStatus,
+			&syncError,
+			&syncUpdatedAt,
+			pq.Array(&pullRequest.AssignedReviewersIds),
+		); err != nil {
+			logger.Error("scan error (ExportPullRequests)", zap.Error(err))
+			return nil, err
+		}
+
+		if mergedAt.Valid {
+			pullRequest.MergedAt = &mergedAt.Time
+		}
+		if syncStatus.Valid {
+			pullRequest.ReviewersSync = &entity.ReviewersSync{
+				Status:    syncStatus.String,
+				Error:     syncError,
+				UpdatedAt: syncUpdatedAt.Time,
+			}
+		}
+		pullRequests = append(pullRequests, &pullRequest)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (ExportPullRequests)", zap.Error(err))
+		return nil, err
+	}
+
+	return pullRequests, nil
+}
+
+func (r *repository) GetReviewersByPrIds(ctx context.Context, prIds []string) (map[string][]*entity.PullRequestReviewer, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	reviewers := make(map[string][]*entity.PullRequestReviewer)
+	if len(prIds) == 0 {
+		return reviewers, nil
+	}
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetReviewersByPrIdsQuery, pq.Array(prIds))
+	if err != nil {
+		logger.Error("failed to get reviewers by pr ids (GetReviewersByPrIds)", zap.Error(err))
+		return nil, err
+	}
+
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	for rows.Next() {
+		var prId string
+		var reviewer entity.PullRequestReviewer
+		if err := rows.Scan(&prId, &reviewer.UserId, &reviewer.Username, &reviewer.IsActive, &reviewer.AssignedAt); err != nil {
+			logger.Error("scan error (GetReviewersByPrIds)", zap.Error(err))
+			return nil, err
+		}
+		reviewers[prId] = append(reviewers[prId], &reviewer)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (GetReviewersByPrIds)", zap.Error(err))
+		return nil, err
+	}
+
+	return reviewers, nil
+}
+
+func scanPullRequestsShort(ctx context.Context, rows *sql.Rows) (pullRequests []*entity.PullRequestShort, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
 
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	pullRequests = make([]*entity.PullRequestShort, 0)
 	for rows.Next() {
 		var pr entity.PullRequestShort
 		var mergedAt sql.NullTime
-		if err := rows.Scan(&pr.Id, &pr.PrName, &pr.AuthorId, &pr.Status, &mergedAt); err != nil {
-			logger.Error("scan error (GetPullRequestsByReviewerId)", zap.Error(err))
+		if err := rows.Scan(&pr.Id, &pr.PrName, &pr.AuthorId, &pr.Status, &pr.CreatedAt, &mergedAt); err != nil {
+			logger.Error("scan error (scanPullRequestsShort)", zap.Error(err))
 			return nil, err
 		}
 
@@ -312,9 +931,191 @@ func (r *repository) GetPullRequestsByReviewerId(ctx context.Context, reviewerId
 	}
 
 	if err := rows.Err(); err != nil {
-		logger.Error("rows iterate error (GetPullRequestsByReviewerId)", zap.Error(err))
+		logger.Error("rows iterate error (scanPullRequestsShort)", zap.Error(err))
 		return nil, err
 	}
 
 	return pullRequests, nil
 }
+
+func (r *repository) CountPullRequestsByReviewerId(ctx context.Context, filter *entity.PullRequestFilter) (int, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	args := append([]any{filter.ReviewerId}, pullRequestFilterArgs(filter)...)
+
+	var total int
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, CountPullRequestsByReviewerIdQuery, args...).Scan(&total)
+	if err != nil {
+		logger.Error("failed to count PRs by reviewer (CountPullRequestsByReviewerId)", zap.String("reviewer_id", filter.ReviewerId), zap.Error(err))
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *repository) GetOpenReviewAssignmentsByTeam(ctx context.Context, teamName string) ([]*entity.ReviewAssignment, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetOpenReviewAssignmentsByTeamQuery, teamName)
+	if err != nil {
+		logger.Error("failed to get open review assignments (GetOpenReviewAssignmentsByTeam)", zap.String("team_name", teamName), zap.Error(err))
+		return nil, err
+	}
+
+	return scanReviewAssignments(ctx, rows)
+}
+
+func (r *repository) GetOpenReviewAssignmentsByReviewers(ctx context.Context, reviewerIds []string) ([]*entity.ReviewAssignment, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetOpenReviewAssignmentsByReviewersQuery, pq.Array(reviewerIds))
+	if err != nil {
+		logger.Error("failed to get open review assignments (GetOpenReviewAssignmentsByReviewers)", zap.Strings("reviewer_ids", reviewerIds), zap.Error(err))
+		return nil, err
+	}
+
+	return scanReviewAssignments(ctx, rows)
+}
+
+func scanReviewAssignments(ctx context.Context, rows *sql.Rows) (assignments []*entity.ReviewAssignment, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	assignments = make([]*entity.ReviewAssignment, 0)
+	for rows.Next() {
+		var assignment entity.ReviewAssignment
+		if err := rows.Scan(&assignment.PullRequestId, &assignment.AuthorId, &assignment.ReviewerId, &assignment.TeamName); err != nil {
+			logger.Error("scan error (scanReviewAssignments)", zap.Error(err))
+			return nil, err
+		}
+		assignments = append(assignments, &assignment)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (scanReviewAssignments)", zap.Error(err))
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func (r *repository) UpdateReviewersBatch(ctx context.Context, moves []*entity.ReviewerMove) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	if len(moves) == 0 {
+		return nil
+	}
+
+	prIds := make([]string, 0, len(moves))
+	oldReviewerIds := make([]string, 0, len(moves))
+	newReviewerIds := make([]string, 0, len(moves))
+	for _, move := range moves {
+		prIds = append(prIds, move.PullRequestId)
+		oldReviewerIds = append(oldReviewerIds, move.FromReviewerId)
+		newReviewerIds = append(newReviewerIds, move.ToReviewerId)
+	}
+
+	res, err := postgres.Conn(ctx, r.db).ExecContext(ctx, UpdateReviewersBatchQuery, pq.Array(prIds), pq.Array(oldReviewerIds), pq.Array(newReviewerIds))
+	if err != nil {
+		logger.Error("failed to update reviewers batch (UpdateReviewersBatch)", zap.Int("moves", len(moves)), zap.Error(err))
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.Error("failed to get rows affected (UpdateReviewersBatch)", zap.Error(err))
+		return err
+	}
+	if affected != int64(len(moves)) {
+		logger.Warn("reviewers changed concurrently (UpdateReviewersBatch)", zap.Int("moves", len(moves)), zap.Int64("affected", affected))
+		return entity.ErrReviewersChanged
+	}
+
+	return nil
+}
+
+func (r *repository) GetUnderReviewedPullRequests(ctx context.Context, required int) (prs []*entity.UnderReviewedPullRequest, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetUnderReviewedPullRequestsQuery, required)
+	if err != nil {
+		logger.Error("failed to get under-reviewed pull requests (GetUnderReviewedPullRequests)", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (GetUnderReviewedPullRequests)", zap.Error(err))
+		}
+	}()
+
+	prs = make([]*entity.UnderReviewedPullRequest, 0)
+	for rows.Next() {
+		var pr entity.UnderReviewedPullRequest
+		if err := rows.Scan(&pr.PullRequestId, &pr.AuthorId, &pr.ReviewersCount); err != nil {
+			logger.Error("scan error (GetUnderReviewedPullRequests)", zap.Error(err))
+			return nil, err
+		}
+		prs = append(prs, &pr)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (GetUnderReviewedPullRequests)", zap.Error(err))
+		return nil, err
+	}
+	return prs, nil
+}
+
+func (r *repository) LockPullRequestForTopUp(ctx context.Context, prId string) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var id string
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, LockPullRequestForTopUpQuery, prId).Scan(&id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		logger.Error("failed to lock pull request (LockPullRequestForTopUp)", zap.Error(err), zap.String("pr_id", prId))
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *repository) MarkOverdueReviews(ctx context.Context) (reviews []*entity.OverdueReview, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, MarkOverdueReviewsQuery)
+	if err != nil {
+		logger.Error("failed to mark overdue reviews (MarkOverdueReviews)", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (MarkOverdueReviews)", zap.Error(err))
+		}
+	}()
+
+	reviews = make([]*entity.OverdueReview, 0)
+	for rows.Next() {
+		var review entity.OverdueReview
+		err := rows.Scan(&review.PullRequestId, &review.ReviewerId, &review.TeamName, &review.AssignedAt, &review.OverdueAt, &review.ReviewSlaHours, &review.AutoReassign)
+		if err != nil {
+			logger.Error("scan error (MarkOverdueReviews)", zap.Error(err))
+			return nil, err
+		}
+		reviews = append(reviews, &review)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (MarkOverdueReviews)", zap.Error(err))
+		return nil, err
+	}
+	return reviews, nil
+}
@@ -6,10 +6,13 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mockird31/avito_tech/internal/entity"
 	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
 	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -95,17 +98,18 @@ func TestGetPullRequestsByReviewerId_Success(t *testing.T) {
 	defer db.Close()
 	ctx := getTestContext()
 
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	reviewerId := "rev-1"
 
-	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "merged_at"}).
-		AddRow("pr1", "Fix bug", "author1", "OPEN", nil).
-		AddRow("pr2", "Add feature", "author2", "MERGED", nil)
+	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "created_at", "merged_at"}).
+		AddRow("pr1", "Fix bug", "author1", "OPEN", createdAt, nil).
+		AddRow("pr2", "Add feature", "author2", "MERGED", createdAt, nil)
 
 	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestsByReviewerIdQuery)).
-		WithArgs(reviewerId).
+		WithArgs(reviewerId, "", nil, nil, nil, nil, nil, "", 10).
 		WillReturnRows(rows)
 
-	prs, err := repo.GetPullRequestsByReviewerId(ctx, reviewerId)
+	prs, err := repo.GetPullRequestsByReviewerId(ctx, &entity.PullRequestFilter{ReviewerId: reviewerId, Order: entity.SortOrderDesc, Limit: 10})
 	require.NoError(t, err)
 	require.Len(t, prs, 2)
 
@@ -132,10 +136,10 @@ func TestGetPullRequestsByReviewerId_NoRows(t *testing.T) {
 	reviewerId := "rev-empty"
 
 	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestsByReviewerIdQuery)).
-		WithArgs(reviewerId).
+		WithArgs(reviewerId, "", nil, nil, nil, nil, nil, "", 10).
 		WillReturnError(sql.ErrNoRows)
 
-	prs, err := repo.GetPullRequestsByReviewerId(ctx, reviewerId)
+	prs, err := repo.GetPullRequestsByReviewerId(ctx, &entity.PullRequestFilter{ReviewerId: reviewerId, Order: entity.SortOrderDesc, Limit: 10})
 	require.NoError(t, err)
 	assert.Empty(t, prs)
 
@@ -151,10 +155,10 @@ func TestGetPullRequestsByReviewerId_DBError(t *testing.T) {
 	dbErr := errors.New("db failure")
 
 	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestsByReviewerIdQuery)).
-		WithArgs(reviewerId).
+		WithArgs(reviewerId, "", nil, nil, nil, nil, nil, "", 10).
 		WillReturnError(dbErr)
 
-	prs, err := repo.GetPullRequestsByReviewerId(ctx, reviewerId)
+	prs, err := repo.GetPullRequestsByReviewerId(ctx, &entity.PullRequestFilter{ReviewerId: reviewerId, Order: entity.SortOrderDesc, Limit: 10})
 	require.Error(t, err)
 	assert.Nil(t, prs)
 	assert.EqualError(t, err, dbErr.Error())
@@ -167,16 +171,17 @@ func TestGetPullRequestsByReviewerId_ScanError(t *testing.T) {
 	defer db.Close()
 	ctx := getTestContext()
 
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	reviewerId := "rev-scan"
 
-	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "merged_at"}).
-		AddRow("pr1", "Fix bug", "author1", "OPEN", "not_time")
+	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "created_at", "merged_at"}).
+		AddRow("pr1", "Fix bug", "author1", "OPEN", createdAt, "not_time")
 
 	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestsByReviewerIdQuery)).
-		WithArgs(reviewerId).
+		WithArgs(reviewerId, "", nil, nil, nil, nil, nil, "", 10).
 		WillReturnRows(rows)
 
-	prs, err := repo.GetPullRequestsByReviewerId(ctx, reviewerId)
+	prs, err := repo.GetPullRequestsByReviewerId(ctx, &entity.PullRequestFilter{ReviewerId: reviewerId, Order: entity.SortOrderDesc, Limit: 10})
 	require.Error(t, err)
 	assert.Nil(t, prs)
 
@@ -188,19 +193,693 @@ func TestGetPullRequestsByReviewerId_RowsErr(t *testing.T) {
 	defer db.Close()
 	ctx := getTestContext()
 
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
 	reviewerId := "rev-rows-err"
 
-	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "merged_at"}).
-		AddRow("pr1", "Fix bug", "author1", "OPEN", nil).
+	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "created_at", "merged_at"}).
+		AddRow("pr1", "Fix bug", "author1", "OPEN", createdAt, nil).
 		RowError(0, errors.New("row iteration error"))
 
 	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestsByReviewerIdQuery)).
-		WithArgs(reviewerId).
+		WithArgs(reviewerId, "", nil, nil, nil, nil, nil, "", 10).
 		WillReturnRows(rows)
 
-	prs, err := repo.GetPullRequestsByReviewerId(ctx, reviewerId)
+	prs, err := repo.GetPullRequestsByReviewerId(ctx, &entity.PullRequestFilter{ReviewerId: reviewerId, Order: entity.SortOrderDesc, Limit: 10})
 	require.Error(t, err)
 	assert.Nil(t, prs)
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestGetPullRequestsByReviewerId_AscWithFiltersAndCursor(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	createdFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cursorAt := time.Date(2025, 1, 5, 0, 0, 0, 0, time.UTC)
+	filter := &entity.PullRequestFilter{
+		ReviewerId:  "rev-1",
+		Status:      entity.StatusOpen.String(),
+		CreatedFrom: &createdFrom,
+		Order:       entity.SortOrderAsc,
+		Cursor:      &entity.PullRequestCursor{CreatedAt: cursorAt, Id: "pr3"},
+		Limit:       2,
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "created_at", "merged_at"}).
+		AddRow("pr4", "Next", "author1", "OPEN", cursorAt.Add(time.Hour), nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestsByReviewerIdAscQuery)).
+		WithArgs("rev-1", "OPEN", createdFrom, nil, nil, nil, cursorAt, "pr3", 2).
+		WillReturnRows(rows)
+
+	prs, err := repo.GetPullRequestsByReviewerId(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, "pr4", prs[0].Id)
+	assert.Equal(t, cursorAt.Add(time.Hour), prs[0].CreatedAt)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountPullRequestsByReviewerId_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(CountPullRequestsByReviewerIdQuery)).
+		WithArgs("rev-1", "MERGED", nil, nil, nil, nil).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	total, err := repo.CountPullRequestsByReviewerId(ctx, &entity.PullRequestFilter{ReviewerId: "rev-1", Status: "MERGED"})
+	require.NoError(t, err)
+	assert.Equal(t, 7, total)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOpenReviewAssignmentsByTeam_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"id", "author_id", "reviewer_id", "team_name"}).
+		AddRow("pr1", "a1", "r1", "teamA").
+		AddRow("pr1", "a1", "r2", "teamA")
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetOpenReviewAssignmentsByTeamQuery)).
+		WithArgs("teamA").
+		WillReturnRows(rows)
+
+	assignments, err := repo.GetOpenReviewAssignmentsByTeam(ctx, "teamA")
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.ReviewAssignment{
+		{PullRequestId: "pr1", AuthorId: "a1", ReviewerId: "r1", TeamName: "teamA"},
+		{PullRequestId: "pr1", AuthorId: "a1", ReviewerId: "r2", TeamName: "teamA"},
+	}, assignments)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOpenReviewAssignmentsByTeam_DBError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	dbErr := errors.New("db failure")
+	mock.ExpectQuery(regexp.QuoteMeta(GetOpenReviewAssignmentsByTeamQuery)).
+		WithArgs("teamA").
+		WillReturnError(dbErr)
+
+	assignments, err := repo.GetOpenReviewAssignmentsByTeam(ctx, "teamA")
+	require.Error(t, err)
+	assert.Nil(t, assignments)
+	assert.EqualError(t, err, dbErr.Error())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOpenReviewAssignmentsByReviewers_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"id", "author_id", "reviewer_id", "team_name"}).
+		AddRow("pr1", "a1", "r1", "teamA").
+		AddRow("pr1", "a1", "r2", "teamA")
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetOpenReviewAssignmentsByReviewersQuery)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	assignments, err := repo.GetOpenReviewAssignmentsByReviewers(ctx, []string{"r1"})
+	require.NoError(t, err)
+	assert.Len(t, assignments, 2)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOpenReviewAssignmentsByReviewers_ScanError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"id", "author_id"}).
+		AddRow("pr1", "a1")
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetOpenReviewAssignmentsByReviewersQuery)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	assignments, err := repo.GetOpenReviewAssignmentsByReviewers(ctx, []string{"r1"})
+	require.Error(t, err)
+	assert.Nil(t, assignments)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateReviewersBatch_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(UpdateReviewersBatchQuery)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := repo.UpdateReviewersBatch(ctx, []*entity.ReviewerMove{
+		{PullRequestId: "pr1", FromReviewerId: "r1", ToReviewerId: "r3"},
+		{PullRequestId: "pr2", FromReviewerId: "r1", ToReviewerId: "r4"},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateReviewersBatch_ReviewersChanged(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(UpdateReviewersBatchQuery)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.UpdateReviewersBatch(ctx, []*entity.ReviewerMove{
+		{PullRequestId: "pr1", FromReviewerId: "r1", ToReviewerId: "r3"},
+		{PullRequestId: "pr2", FromReviewerId: "r1", ToReviewerId: "r4"},
+	})
+	require.ErrorIs(t, err, entity.ErrReviewersChanged)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateReviewersBatch_Empty(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	err := repo.UpdateReviewersBatch(ctx, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateReviewersBatch_DBError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	dbErr := errors.New("update failed")
+	mock.ExpectExec(regexp.QuoteMeta(UpdateReviewersBatchQuery)).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnError(dbErr)
+
+	err := repo.UpdateReviewersBatch(ctx, []*entity.ReviewerMove{
+		{PullRequestId: "pr1", FromReviewerId: "r1", ToReviewerId: "r3"},
+	})
+	require.Error(t, err)
+	assert.EqualError(t, err, dbErr.Error())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPullRequestsByAuthorId_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := &entity.PullRequestFilter{AuthorId: "a1", Status: "OPEN", Order: entity.SortOrderDesc, Limit: 3}
+
+	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "created_at", "merged_at"}).
+		AddRow("pr2", "Second", "a1", "OPEN", createdAt.Add(time.Hour), nil).
+		AddRow("pr1", "First", "a1", "OPEN", createdAt, nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestsByAuthorIdQuery)).
+		WithArgs("a1", "OPEN", nil, nil, nil, nil, nil, "", 3).
+		WillReturnRows(rows)
+
+	prs, err := repo.GetPullRequestsByAuthorId(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	assert.Equal(t, "pr2", prs[0].Id)
+	assert.Equal(t, "pr1", prs[1].Id)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetReviewersByPrIds_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	assignedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rows := sqlmock.NewRows([]string{"pull_request_id", "id", "username", "is_active", "created_at"}).
+		AddRow("pr1", "r1", "bob", true, assignedAt).
+		AddRow("pr1", "r2", "carol", false, assignedAt).
+		AddRow("pr2", "r1", "bob", true, assignedAt)
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetReviewersByPrIdsQuery)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	reviewers, err := repo.GetReviewersByPrIds(ctx, []string{"pr1", "pr2"})
+	require.NoError(t, err)
+	require.Len(t, reviewers["pr1"], 2)
+	require.Len(t, reviewers["pr2"], 1)
+	assert.Equal(t, &entity.PullRequestReviewer{UserId: "r2", Username: "carol", IsActive: false, AssignedAt: assignedAt}, reviewers["pr1"][1])
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetReviewersByPrIds_Empty(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	reviewers, err := repo.GetReviewersByPrIds(ctx, []string{})
+	require.NoError(t, err)
+	assert.Empty(t, reviewers)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPullRequestById_NotFound(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestByIdQuery)).
+		WithArgs("pr-missing").
+		WillReturnError(sql.ErrNoRows)
+
+	pr, err := repo.GetPullRequestById(ctx, "pr-missing")
+	assert.Nil(t, pr)
+	assert.ErrorIs(t, err, entity.ErrPullRequestNotExist)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListPullRequests_AllFilters(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := &entity.PullRequestFilter{
+		AuthorId:   "a1",
+		ReviewerId: "r1",
+		TeamName:   "teamA",
+		NameSearch: "100%",
+		Status:     "OPEN",
+		Order:      entity.SortOrderDesc,
+		Limit:      5,
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "created_at", "merged_at"}).
+		AddRow("pr1", "100% coverage", "a1", "OPEN", createdAt, nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(ListPullRequestsQuery)).
+		WithArgs("a1", "OPEN", nil, nil, nil, nil, "teamA", "r1", `100\%`, nil, "", 5).
+		WillReturnRows(rows)
+
+	prs, err := repo.ListPullRequests(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, "pr1", prs[0].Id)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountPullRequests_NoFilters(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(CountPullRequestsQuery)).
+		WithArgs("", "", nil, nil, nil, nil, "", "", "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+
+	total, err := repo.CountPullRequests(ctx, &entity.PullRequestFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 12, total)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPullRequestById_WithMetadata(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "description", "labels", "priority", "version", "merged_at", "reviewers_sync_status", "reviewers_sync_error", "reviewers_sync_updated_at"}).
+		AddRow("pr1", "Fix bug", "a1", "OPEN", "details", "{backend,urgent}", "HIGH", 3, nil, nil, "", nil)
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestByIdQuery)).
+		WithArgs("pr1").
+		WillReturnRows(rows)
+
+	pr, err := repo.GetPullRequestById(ctx, "pr1")
+	require.NoError(t, err)
+	assert.Equal(t, &entity.PullRequest{
+		Id:          "pr1",
+		PrName:      "Fix bug",
+		AuthorId:    "a1",
+		Status:      "OPEN",
+		Description: "details",
+		Labels:      []string{"backend", "urgent"},
+		Priority:    "HIGH",
+		Version:     3,
+	}, pr)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPullRequestById_WithReviewersSync(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	syncedAt := time.Date(2025, 1, 2, 10, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "description", "labels", "priority", "version", "merged_at", "reviewers_sync_status", "reviewers_sync_error", "reviewers_sync_updated_at"}).
+		AddRow("github:acme/api#42", "Add search", "a1", "OPEN", "", "{}", "MEDIUM", 1, nil, entity.ReviewersSyncFailed, "github: 422", syncedAt)
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetPullRequestByIdQuery)).
+		WithArgs("github:acme/api#42").
+		WillReturnRows(rows)
+
+	pr, err := repo.GetPullRequestById(ctx, "github:acme/api#42")
+	require.NoError(t, err)
+	assert.Equal(t, &entity.ReviewersSync{Status: entity.ReviewersSyncFailed, Error: "github: 422", UpdatedAt: syncedAt}, pr.ReviewersSync)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetReviewerSyncStatus_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(SetReviewerSyncStatusQuery)).
+		WithArgs("github:acme/api#42", "u2", entity.ReviewersSyncSynced, "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetReviewerSyncStatus(ctx, "github:acme/api#42", "u2", entity.ReviewersSyncSynced, "")
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExportPullRequests_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mergedAt := time.Date(2025, 3, 11, 9, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "name", "author_id", "status", "description", "labels", "priority", "version", "merged_at",
+		"reviewers_sync_status", "reviewers_sync_error", "reviewers_sync_updated_at", "reviewers"}).
+		AddRow("pr1", "Feature", "u1", "MERGED", "Adds search", "{backend}", "HIGH", 3, mergedAt, nil, "", nil, "{u2,u3}").
+		AddRow("pr2", "Fix", "u2", "OPEN", "", "{}", "MEDIUM", 1, nil, nil, "", nil, "{}")
+	mock.ExpectQuery(regexp.QuoteMeta(ExportPullRequestsQuery)).
+		WithArgs("", 100).
+		WillReturnRows(rows)
+
+	got, err := repo.ExportPullRequests(ctx, "", 100)
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.PullRequest{
+		{Id: "pr1", PrName: "Feature", AuthorId: "u1", Status: "MERGED", Description: "Adds search", Labels: []string{"backend"},
+			Priority: "HIGH", Version: 3, MergedAt: &mergedAt, AssignedReviewersIds: []string{"u2", "u3"}},
+		{Id: "pr2", PrName: "Fix", AuthorId: "u2", Status: "OPEN", Labels: []string{},
+			Priority: "MEDIUM", Version: 1, AssignedReviewersIds: []string{}},
+	}, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClearReviewerSyncStatus_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(SetReviewerSyncStatusQuery)).
+		WithArgs("github:acme/api#42", "u2", nil, "").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.ClearReviewerSyncStatus(ctx, "github:acme/api#42", "u2")
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePullRequest_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	pullRequest := &entity.PullRequest{
+		Id:          "pr1",
+		PrName:      "Feature",
+		AuthorId:    "u1",
+		Description: "Adds search",
+		Labels:      []string{"backend"},
+		Priority:    entity.PriorityHigh,
+	}
+
+	mock.ExpectExec(regexp.QuoteMeta(CreatePullRequestQuery)).
+		WithArgs("pr1", "Feature", "u1", entity.StatusOpen, "Adds search", pq.Array([]string{"backend"}), entity.PriorityHigh).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.CreatePullRequest(ctx, pullRequest)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePullRequest_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	name := "Renamed"
+	update := &entity.PullRequestUpdate{Id: "pr1", Version: 2, PrName: &name}
+
+	mock.ExpectExec(regexp.QuoteMeta(UpdatePullRequestQuery)).
+		WithArgs("Renamed", nil, nil, nil, "pr1", 2).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.UpdatePullRequest(ctx, update)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePullRequest_VersionConflict(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	update := &entity.PullRequestUpdate{Id: "pr1", Version: 1, Labels: []string{}}
+
+	mock.ExpectExec(regexp.QuoteMeta(UpdatePullRequestQuery)).
+		WithArgs(nil, nil, "{}", nil, "pr1", 1).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.UpdatePullRequest(ctx, update)
+	assert.ErrorIs(t, err, entity.ErrVersionConflict)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClosePullRequest_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(ClosePullRequestQuery)).
+		WithArgs("pr1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.ClosePullRequest(ctx, "pr1")
+	assert.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClosePullRequest_NotOpen(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(ClosePullRequestQuery)).
+		WithArgs("pr1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.ClosePullRequest(ctx, "pr1")
+	assert.ErrorIs(t, err, entity.ErrPullRequestNotOpen)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReopenPullRequest_NotClosed(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(ReopenPullRequestQuery)).
+		WithArgs("pr1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.ReopenPullRequest(ctx, "pr1")
+	assert.ErrorIs(t, err, entity.ErrPullRequestNotClosed)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemoveReviewer_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(RemoveReviewerQuery)).
+		WithArgs("pr1", "u3").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.RemoveReviewer(ctx, "pr1", "u3")
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetReviewerLimitsForUpdate_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetReviewerLimitsForUpdateQuery)).
+		WithArgs("pr1").
+		WillReturnRows(sqlmock.NewRows([]string{"name", "min_reviewers", "max_reviewers"}).AddRow("teamA", 1, 3))
+
+	limits, err := repo.GetReviewerLimitsForUpdate(ctx, "pr1")
+	require.NoError(t, err)
+	assert.Equal(t, &entity.TeamReviewerLimits{TeamName: "teamA", MinReviewers: 1, MaxReviewers: 3}, limits)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetReviewerLimitsForUpdate_NotFound(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetReviewerLimitsForUpdateQuery)).
+		WithArgs("pr1").
+		WillReturnError(sql.ErrNoRows)
+
+	limits, err := repo.GetReviewerLimitsForUpdate(ctx, "pr1")
+	assert.Nil(t, limits)
+	assert.ErrorIs(t, err, entity.ErrPullRequestNotExist)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordEvent_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(RecordEventQuery)).
+		WithArgs("pr1", entity.EventReviewerAdded, "u3").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := repo.RecordEvent(ctx, &entity.PullRequestEvent{PullRequestId: "pr1", EventType: entity.EventReviewerAdded, ReviewerId: "u3"})
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUnderReviewedPullRequests_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"id", "author_id", "count"}).
+		AddRow("pr1", "a1", 0).
+		AddRow("pr2", "a2", 1)
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetUnderReviewedPullRequestsQuery)).
+		WithArgs(entity.RequiredReviewersCount).
+		WillReturnRows(rows)
+
+	prs, err := repo.GetUnderReviewedPullRequests(ctx, entity.RequiredReviewersCount)
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.UnderReviewedPullRequest{
+		{PullRequestId: "pr1", AuthorId: "a1", ReviewersCount: 0},
+		{PullRequestId: "pr2", AuthorId: "a2", ReviewersCount: 1},
+	}, prs)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUnderReviewedPullRequests_DBError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetUnderReviewedPullRequestsQuery)).
+		WithArgs(entity.RequiredReviewersCount).
+		WillReturnError(errors.New("db failure"))
+
+	prs, err := repo.GetUnderReviewedPullRequests(ctx, entity.RequiredReviewersCount)
+	require.Error(t, err)
+	assert.Nil(t, prs)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLockPullRequestForTopUp(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(LockPullRequestForTopUpQuery)).
+		WithArgs("pr1").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("pr1"))
+	mock.ExpectQuery(regexp.QuoteMeta(LockPullRequestForTopUpQuery)).
+		WithArgs("pr2").
+		WillReturnError(sql.ErrNoRows)
+
+	locked, err := repo.LockPullRequestForTopUp(ctx, "pr1")
+	require.NoError(t, err)
+	assert.True(t, locked)
+
+	locked, err = repo.LockPullRequestForTopUp(ctx, "pr2")
+	require.NoError(t, err)
+	assert.False(t, locked)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkOverdueReviews_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	assignedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	overdueAt := assignedAt.Add(25 * time.Hour)
+	rows := sqlmock.NewRows([]string{"pull_request_id", "reviewer_id", "name", "created_at", "overdue_at", "review_sla_hours", "review_sla_auto_reassign"}).
+		AddRow("pr1", "u2", "backend", assignedAt, overdueAt, 24, true)
+
+	mock.ExpectQuery(regexp.QuoteMeta(MarkOverdueReviewsQuery)).
+		WillReturnRows(rows)
+
+	got, err := repo.MarkOverdueReviews(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.OverdueReview{
+		{PullRequestId: "pr1", ReviewerId: "u2", TeamName: "backend", AssignedAt: assignedAt, OverdueAt: overdueAt, ReviewSlaHours: 24, AutoReassign: true},
+	}, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -8,7 +8,16 @@ import (
 
 type IUsecase interface {
 	GetPullRequestById(ctx context.Context, prId string) (*entity.PullRequest, error)
+	AddReviewer(ctx context.Context, change *entity.PullRequestReviewerChange) (*entity.PullRequest, error)
+	RemoveReviewer(ctx context.Context, change *entity.PullRequestReviewerChange) (*entity.PullRequest, error)
+	UpdatePullRequest(ctx context.Context, pullRequestUpdate *entity.PullRequestUpdate) (*entity.PullRequest, error)
+	ListPullRequests(ctx context.Context, filter *entity.PullRequestFilter) (*entity.PullRequestList, error)
+	ExportPullRequests(ctx context.Context, afterId string, limit int) ([]*entity.PullRequest, error)
 	CreatePullRequest(ctx context.Context, pullRequestCreate *entity.PullRequest) (*entity.PullRequest, error)
 	MergePullRequest(ctx context.Context, pullRequestMerge *entity.PullRequest) (*entity.PullRequest, error)
-	ReassignPullRequest(ctx context.Context, pullRequestReassign *entity.PullRequestReassignRequest) (*entity.PullRequest, string, error)
+	ClosePullRequest(ctx context.Context, pullRequestClose *entity.PullRequest) (*entity.PullRequest, error)
+	ReopenPullRequest(ctx context.Context, pullRequestReopen *entity.PullRequest) (*entity.PullRequest, error)
+	ReconcileReviewers(ctx context.Context) (*entity.ReconcileResult, error)
+	EscalateOverdueReviews(ctx context.Context) (*entity.OverdueSweepResult, error)
+	ReassignPullRequest(ctx context.Context, pullRequestReassign *entity.PullRequestReassignRequest) (*entity.PullRequestReassignResult, error)
 }
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ReconcileReviewers добирает ревьюверов на открытые pull request'ы, где их меньше RequiredReviewersCount,
+// по тем же правилам, что и при создании. Pull request'ы, с которых ревьювера сняли вручную, пропускаются.
+// Ошибка на одном pull request'е не останавливает остальные.
+func (u *usecase) ReconcileReviewers(ctx context.Context) (*entity.ReconcileResult, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	prs, err := u.PRRepository.GetUnderReviewedPullRequests(ctx, entity.RequiredReviewersCount)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity.ReconcileResult{
+		Checked:  len(prs),
+		ToppedUp: make([]*entity.ReconcileTopUp, 0),
+	}
+	for _, pr := range prs {
+		added, err := u.topUpReviewers(ctx, pr)
+		if err != nil {
+			logger.Error("failed to top up reviewers (ReconcileReviewers)", zap.Error(err), zap.String("pr_id", pr.PullRequestId))
+		}
+		if len(added) == 0 {
+			continue
+		}
+		result.Added += len(added)
+		result.ToppedUp = append(result.ToppedUp, &entity.ReconcileTopUp{PullRequestId: pr.PullRequestId, AddedReviewerIds: added})
+	}
+
+	return result, nil
+}
+
+// topUpReviewers назначает недостающих ревьюверов в одной транзакции под блокировкой строки pull request'а.
+// Если строку уже держит другая сверка (в том числе на другой реплике) или ручное изменение ревьюверов,
+// pull request пропускается. Ревьюверы пересчитываются под блокировкой, а уже назначенные исключаются
+// запросом поиска, поэтому каждый следующий кандидат выбирается с учетом предыдущего.
+func (u *usecase) topUpReviewers(ctx context.Context, pr *entity.UnderReviewedPullRequest) ([]string, error) {
+	added := make([]string, 0)
+	err := u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		locked, err := u.PRRepository.LockPullRequestForTopUp(ctx, pr.PullRequestId)
+		if err != nil || !locked {
+			return err
+		}
+
+		reviewers, err := u.PRRepository.GetReviewersByPrId(ctx, pr.PullRequestId)
+		if err != nil {
+			return err
+		}
+
+		events := make([]*entity.DomainEvent, 0)
+		for i := len(reviewers); i < entity.RequiredReviewersCount; i++ {
+			reviewerId, err := u.UserRepository.FindNewReviewerExcluding(ctx, pr.PullRequestId, pr.AuthorId, []string{})
+			if err != nil {
+				return err
+			}
+			if reviewerId == "" {
+				break
+			}
+
+			err = u.PRRepository.ConnectReviewersWithPullRequest(ctx, pr.PullRequestId, []string{reviewerId})
+			if err != nil {
+				return err
+			}
+
+			err = u.PRRepository.RecordEvent(ctx, &entity.PullRequestEvent{
+				PullRequestId: pr.PullRequestId,
+				EventType:     entity.EventReviewerAutoAdded,
+				ReviewerId:    reviewerId,
+			})
+			if err != nil {
+				return err
+			}
+			events = append(events, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: pr.PullRequestId, ReviewerId: reviewerId})
+			added = append(added, reviewerId)
+		}
+
+		if len(events) == 0 {
+			return nil
+		}
+		return u.publish(ctx, events...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+func (u *usecase) AddReviewer(ctx context.Context, change *entity.PullRequestReviewerChange) (*entity.PullRequest, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	if err := u.checkOpenPullRequest(ctx, change.Id); err != nil {
+		return nil, err
+	}
+
+	// лимиты команды автора читаются с блокировкой pull request'а, поэтому состав ревьюверов
+	// не меняется параллельно до конца транзакции
+	err := u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		limits, err := u.PRRepository.GetReviewerLimitsForUpdate(ctx, change.Id)
+		if err != nil {
+			return err
+		}
+
+		reviewers, err := u.PRRepository.GetReviewersByPrId(ctx, change.Id)
+		if err != nil {
+			return err
+		}
+
+		if err := u.checkReviewerEligible(ctx, change.Id, change.ReviewerId, reviewers); err != nil {
+			return err
+		}
+
+		if len(reviewers) >= limits.MaxReviewers {
+			return entity.ErrTooManyReviewers
+		}
+
+		err = u.PRRepository.ConnectReviewersWithPullRequest(ctx, change.Id, []string{change.ReviewerId})
+		if err != nil {
+			return err
+		}
+
+		err = u.PRRepository.RecordEvent(ctx, &entity.PullRequestEvent{
+			PullRequestId: change.Id,
+			EventType:     entity.EventReviewerAdded,
+			ReviewerId:    change.ReviewerId,
+		})
+		if err != nil {
+			return err
+		}
+		return u.publish(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: change.Id, ReviewerId: change.ReviewerId})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("reviewer added (AddReviewer)", zap.String("pr_id", change.Id), zap.String("reviewer_id", change.ReviewerId))
+	return u.GetPullRequestById(ctx, change.Id)
+}
+
+func (u *usecase) RemoveReviewer(ctx context.Context, change *entity.PullRequestReviewerChange) (*entity.PullRequest, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	if err := u.checkOpenPullRequest(ctx, change.Id); err != nil {
+		return nil, err
+	}
+
+	err := u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		limits, err := u.PRRepository.GetReviewerLimitsForUpdate(ctx, change.Id)
+		if err != nil {
+			return err
+		}
+
+		reviewers, err := u.PRRepository.GetReviewersByPrId(ctx, change.Id)
+		if err != nil {
+			return err
+		}
+
+		if !slices.Contains(reviewers, change.ReviewerId) {
+			return entity.ErrReviewerNotAssigned
+		}
+
+		if len(reviewers)-1 < limits.MinReviewers {
+			return entity.ErrTooFewReviewers
+		}
+
+		err = u.PRRepository.RemoveReviewer(ctx, change.Id, change.ReviewerId)
+		if err != nil {
+			return err
+		}
+
+		err = u.PRRepository.RecordEvent(ctx, &entity.PullRequestEvent{
+			PullRequestId: change.Id,
+			EventType:     entity.EventReviewerRemoved,
+			ReviewerId:    change.ReviewerId,
+		})
+		if err != nil {
+			return err
+		}
+		return u.publish(ctx, &entity.DomainEvent{Type: entity.EventTypeReviewerRemoved, PullRequestId: change.Id, ReviewerId: change.ReviewerId})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("reviewer removed (RemoveReviewer)", zap.String("pr_id", change.Id), zap.String("reviewer_id", change.ReviewerId))
+	return u.GetPullRequestById(ctx, change.Id)
+}
+
+func (u *usecase) checkOpenPullRequest(ctx context.Context, prId string) error {
+	isExist, err := u.PRRepository.CheckPullRequestExistById(ctx, prId)
+	if err != nil {
+		return err
+	}
+
+	if !isExist {
+		return entity.ErrPullRequestNotExist
+	}
+
+	isMerged, err := u.PRRepository.CheckPullRequestIsMergedById(ctx, prId)
+	if err != nil {
+		return err
+	}
+
+	if isMerged {
+		return entity.ErrPullRequestMerged
+	}
+	return nil
+}
+
+// checkReviewerEligible проверяет, что пользователь может стать ревьювером pull request'а:
+// он существует, активен, состоит в команде автора, не является автором, еще не назначен
+// и не достиг лимита открытых ревью.
+func (u *usecase) checkReviewerEligible(ctx context.Context, prId string, reviewerId string, reviewers []string) error {
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, reviewerId)
+	if err != nil {
+		return err
+	}
+
+	if !isExist {
+		return entity.ErrReviewerNotFound
+	}
+
+	authorId, err := u.PRRepository.GetAuthorIdByPRId(ctx, prId)
+	if err != nil {
+		return err
+	}
+
+	if reviewerId == authorId {
+		return entity.ErrReviewerIsAuthor
+	}
+
+	if slices.Contains(reviewers, reviewerId) {
+		return entity.ErrReviewerAlreadyAssigned
+	}
+
+	reviewer, err := u.UserRepository.GetUserById(ctx, reviewerId)
+	if err != nil {
+		return err
+	}
+
+	if !reviewer.IsActive {
+		return entity.ErrReviewerInactive
+	}
+
+	author, err := u.UserRepository.GetUserById(ctx, authorId)
+	if err != nil {
+		return err
+	}
+
+	if reviewer.TeamName != author.TeamName {
+		return entity.ErrReviewerNotInTeam
+	}
+
+	isBelowCapacity, err := u.UserRepository.CheckBelowReviewCapacity(ctx, reviewerId)
+	if err != nil {
+		return err
+	}
+
+	if !isBelowCapacity {
+		return entity.ErrReviewerAtCapacity
+	}
+	return nil
+}
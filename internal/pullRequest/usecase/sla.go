@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// EscalateOverdueReviews помечает ревью, просроченные по SLA команды автора, и для команд
+// с auto_reassign переназначает их так же, как /pullRequest/reassign. Каждое назначение помечается один раз,
+// а переназначение сбрасывает отметку, поэтому новый ревьювер получает полный SLA.
+func (u *usecase) EscalateOverdueReviews(ctx context.Context) (*entity.OverdueSweepResult, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	overdue, err := u.PRRepository.MarkOverdueReviews(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entity.OverdueSweepResult{Marked: len(overdue)}
+	for _, review := range overdue {
+		logger.Info("review is overdue (EscalateOverdueReviews)", zap.String("pr_id", review.PullRequestId), zap.String("reviewer_id", review.ReviewerId), zap.Int("sla_hours", review.ReviewSlaHours))
+		if !review.AutoReassign {
+			continue
+		}
+
+		reassign, err := u.ReassignPullRequest(ctx, &entity.PullRequestReassignRequest{Id: review.PullRequestId, OldReviewerId: review.ReviewerId})
+		if err != nil {
+			logger.Error("failed to reassign overdue review (EscalateOverdueReviews)", zap.Error(err), zap.String("pr_id", review.PullRequestId))
+			continue
+		}
+		if reassign.Outcome == entity.ReassignmentReassigned {
+			result.Reassigned++
+		}
+	}
+
+	return result, nil
+}
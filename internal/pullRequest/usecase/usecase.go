@@ -2,8 +2,11 @@ package usecase
 
 import (
 	"context"
+	"slices"
+	"time"
 
 	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/outbox"
 	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
 	"github.com/Mockird31/avito_tech/internal/team"
 	"github.com/Mockird31/avito_tech/internal/user"
@@ -15,16 +18,30 @@ type usecase struct {
 	PRRepository   pullrequest.IRepository
 	UserRepository user.IRepository
 	TeamRepository team.IRepository
+	Outbox         outbox.IRepository
+	Transactor     outbox.ITransactor
 }
 
-func NewUsecase(PRRepository pullrequest.IRepository, UserRepository user.IRepository, TeamRepository team.IRepository) pullrequest.IUsecase {
+func NewUsecase(PRRepository pullrequest.IRepository, UserRepository user.IRepository, TeamRepository team.IRepository, Outbox outbox.IRepository, Transactor outbox.ITransactor) pullrequest.IUsecase {
 	return &usecase{
 		PRRepository:   PRRepository,
 		UserRepository: UserRepository,
 		TeamRepository: TeamRepository,
+		Outbox:         Outbox,
+		Transactor:     Transactor,
 	}
 }
 
+// publish записывает события в outbox. Вызывается внутри WithinTx вместе с изменением,
+// которое их породило, чтобы событие не потерялось и не ушло без изменения.
+func (u *usecase) publish(ctx context.Context, events ...*entity.DomainEvent) error {
+	occurredAt := time.Now().UTC()
+	for _, event := range events {
+		event.OccurredAt = occurredAt
+	}
+	return u.Outbox.AddEvents(ctx, events)
+}
+
 func (u *usecase) GetPullRequestById(ctx context.Context, prId string) (*entity.PullRequest, error) {
 	pullrequest, err := u.PRRepository.GetPullRequestById(ctx, prId)
 	if err != nil {
@@ -41,9 +58,121 @@ func (u *usecase) GetPullRequestById(ctx context.Context, prId string) (*entity.
 	return pullrequest, nil
 }
 
+func (u *usecase) UpdatePullRequest(ctx context.Context, pullRequestUpdate *entity.PullRequestUpdate) (*entity.PullRequest, error) {
+	if pullRequestUpdate.PrName == nil && pullRequestUpdate.Description == nil && pullRequestUpdate.Labels == nil && pullRequestUpdate.Priority == nil {
+		return nil, entity.ErrNothingToUpdate
+	}
+
+	if pullRequestUpdate.PrName != nil && *pullRequestUpdate.PrName == "" {
+		return nil, entity.ErrEmptyPullRequestName
+	}
+
+	if pullRequestUpdate.Priority != nil {
+		if err := validatePriority(*pullRequestUpdate.Priority); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateLabels(pullRequestUpdate.Labels); err != nil {
+		return nil, err
+	}
+
+	isExist, err := u.PRRepository.CheckPullRequestExistById(ctx, pullRequestUpdate.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		return nil, entity.ErrPullRequestNotExist
+	}
+
+	err = u.PRRepository.UpdatePullRequest(ctx, pullRequestUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetPullRequestById(ctx, pullRequestUpdate.Id)
+}
+
+func validatePriority(priority string) error {
+	switch priority {
+	case entity.PriorityLow, entity.PriorityMedium, entity.PriorityHigh:
+		return nil
+	}
+	return entity.ErrInvalidPriority
+}
+
+func validateLabels(labels []string) error {
+	if len(labels) > entity.MaxLabelsCount {
+		return entity.ErrInvalidLabels
+	}
+	for _, label := range labels {
+		if label == "" || len(label) > entity.MaxLabelLength {
+			return entity.ErrInvalidLabels
+		}
+	}
+	return nil
+}
+
+func (u *usecase) ListPullRequests(ctx context.Context, filter *entity.PullRequestFilter) (*entity.PullRequestList, error) {
+	if err := filter.Normalize(); err != nil {
+		return nil, err
+	}
+
+	// одна лишняя строка показывает, есть ли следующая страница
+	page := *filter
+	page.Limit = filter.Limit + 1
+
+	pullRequests, err := u.PRRepository.ListPullRequests(ctx, &page)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := u.PRRepository.CountPullRequests(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequests, nextCursor := entity.PagePullRequests(pullRequests, filter.Limit)
+
+	return &entity.PullRequestList{
+		PullRequests: pullRequests,
+		Total:        total,
+		NextCursor:   nextCursor,
+	}, nil
+}
+
+// ExportPullRequests отдает страницу pull request'ов с ревьюверами по возрастанию id, начиная после afterId.
+func (u *usecase) ExportPullRequests(ctx context.Context, afterId string, limit int) ([]*entity.PullRequest, error) {
+	return u.PRRepository.ExportPullRequests(ctx, afterId, limit)
+}
+
+// CreatePullRequest создает pull request с описанием, метками и приоритетом из запроса (без приоритета - MEDIUM)
+// и назначает ревьюверов из команды автора.
 func (u *usecase) CreatePullRequest(ctx context.Context, pullRequestCreate *entity.PullRequest) (*entity.PullRequest, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
+	newPullRequest := &entity.PullRequest{
+		Id:          pullRequestCreate.Id,
+		PrName:      pullRequestCreate.PrName,
+		AuthorId:    pullRequestCreate.AuthorId,
+		Description: pullRequestCreate.Description,
+		Labels:      pullRequestCreate.Labels,
+		Priority:    pullRequestCreate.Priority,
+	}
+	if newPullRequest.Labels == nil {
+		newPullRequest.Labels = []string{}
+	}
+	if newPullRequest.Priority == "" {
+		newPullRequest.Priority = entity.DefaultPriority
+	}
+	if err := validatePriority(newPullRequest.Priority); err != nil {
+		return nil, err
+	}
+	if err := validateLabels(newPullRequest.Labels); err != nil {
+		return nil, err
+	}
+
 	isExist, err := u.PRRepository.CheckPullRequestExistById(ctx, pullRequestCreate.Id)
 	if err != nil {
 		return nil, err
@@ -74,21 +203,36 @@ func (u *usecase) CreatePullRequest(ctx context.Context, pullRequestCreate *enti
 		return nil, entity.ErrAuthorOrTeamNotExist
 	}
 
-	err = u.PRRepository.CreatePullRequest(ctx, pullRequestCreate.Id, pullRequestCreate.PrName, pullRequestCreate.AuthorId)
-	if err != nil {
-		return nil, err
-	}
+	var reviewersIds []string
+	err = u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		err := u.PRRepository.CreatePullRequest(ctx, newPullRequest)
+		if err != nil {
+			return err
+		}
 
-	reviewersIds, err := u.UserRepository.FindReviewers(ctx, pullRequestCreate.AuthorId)
-	if err != nil {
-		return nil, err
-	}
+		reviewersIds, err = u.UserRepository.FindReviewers(ctx, pullRequestCreate.AuthorId)
+		if err != nil {
+			return err
+		}
+
+		events := make([]*entity.DomainEvent, 0, len(reviewersIds)+1)
+		events = append(events, &entity.DomainEvent{Type: entity.EventTypePullRequestCreated, PullRequestId: pullRequestCreate.Id})
+		if len(reviewersIds) == 0 {
+			return u.publish(ctx, events...)
+		}
 
-	if len(reviewersIds) > 0 {
 		err = u.PRRepository.ConnectReviewersWithPullRequest(ctx, pullRequestCreate.Id, reviewersIds)
 		if err != nil {
-			return nil, err
+			return err
 		}
+
+		for _, reviewerId := range reviewersIds {
+			events = append(events, &entity.DomainEvent{Type: entity.EventTypeReviewerAssigned, PullRequestId: pullRequestCreate.Id, ReviewerId: reviewerId})
+		}
+		return u.publish(ctx, events...)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	pullRequest := &entity.PullRequest{
@@ -97,10 +241,43 @@ func (u *usecase) CreatePullRequest(ctx context.Context, pullRequestCreate *enti
 		AuthorId:             pullRequestCreate.AuthorId,
 		Status:               "OPEN",
 		AssignedReviewersIds: reviewersIds,
+		Description:          newPullRequest.Description,
+		Labels:               newPullRequest.Labels,
+		Priority:             newPullRequest.Priority,
+		Version:              1,
+	}
+
+	if len(reviewersIds) < entity.RequiredReviewersCount {
+		shortfall, err := u.getReviewerShortfall(ctx, pullRequestCreate.AuthorId, len(reviewersIds))
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("not enough reviewers assigned (CreatePullRequest)", zap.String("pr_id", pullRequestCreate.Id), zap.Int("assigned", shortfall.Assigned), zap.String("reason", shortfall.Reason))
+		pullRequest.ReviewerShortfall = shortfall
 	}
+
 	return pullRequest, nil
 }
 
+func (u *usecase) getReviewerShortfall(ctx context.Context, authorId string, assigned int) (*entity.ReviewerShortfall, error) {
+	atCapacity, err := u.UserRepository.CountCandidatesAtCapacity(ctx, authorId)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := entity.ShortfallReasonNoCandidates
+	if atCapacity > 0 {
+		reason = entity.ShortfallReasonAtCapacity
+	}
+
+	return &entity.ReviewerShortfall{
+		Required:   entity.RequiredReviewersCount,
+		Assigned:   assigned,
+		AtCapacity: atCapacity,
+		Reason:     reason,
+	}, nil
+}
+
 func (u *usecase) MergePullRequest(ctx context.Context, pullRequestMerge *entity.PullRequest) (*entity.PullRequest, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
@@ -120,7 +297,12 @@ func (u *usecase) MergePullRequest(ctx context.Context, pullRequestMerge *entity
 	}
 
 	if !isMerged {
-		err := u.PRRepository.MergePullRequest(ctx, pullRequestMerge.Id)
+		err := u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+			if err := u.PRRepository.MergePullRequest(ctx, pullRequestMerge.Id); err != nil {
+				return err
+			}
+			return u.publish(ctx, &entity.DomainEvent{Type: entity.EventTypePullRequestMerged, PullRequestId: pullRequestMerge.Id})
+		})
 		if err != nil {
 			return nil, err
 		}
@@ -134,40 +316,74 @@ func (u *usecase) MergePullRequest(ctx context.Context, pullRequestMerge *entity
 	return pullRequest, nil
 }
 
-func (u *usecase) ReassignPullRequest(ctx context.Context, pullRequestReassign *entity.PullRequestReassignRequest) (*entity.PullRequest, string, error) {
+// ClosePullRequest закрывает открытый pull request без merge. Ревьюверы остаются в истории,
+// но закрытый pull request не занимает их лимит и не попадает в сверку и проверку SLA.
+func (u *usecase) ClosePullRequest(ctx context.Context, pullRequestClose *entity.PullRequest) (*entity.PullRequest, error) {
+	return u.changePullRequestStatus(ctx, pullRequestClose.Id, u.PRRepository.ClosePullRequest, entity.EventTypePullRequestClosed)
+}
+
+// ReopenPullRequest снова открывает закрытый без merge pull request с прежними ревьюверами.
+func (u *usecase) ReopenPullRequest(ctx context.Context, pullRequestReopen *entity.PullRequest) (*entity.PullRequest, error) {
+	return u.changePullRequestStatus(ctx, pullRequestReopen.Id, u.PRRepository.ReopenPullRequest, entity.EventTypePullRequestReopened)
+}
+
+func (u *usecase) changePullRequestStatus(ctx context.Context, prId string, change func(ctx context.Context, prId string) error, eventType string) (*entity.PullRequest, error) {
+	isExist, err := u.PRRepository.CheckPullRequestExistById(ctx, prId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		return nil, entity.ErrPullRequestNotExist
+	}
+
+	err = u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := change(ctx, prId); err != nil {
+			return err
+		}
+		return u.publish(ctx, &entity.DomainEvent{Type: eventType, PullRequestId: prId})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.GetPullRequestById(ctx, prId)
+}
+
+func (u *usecase) ReassignPullRequest(ctx context.Context, pullRequestReassign *entity.PullRequestReassignRequest) (*entity.PullRequestReassignResult, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
 	isExist, err := u.PRRepository.CheckPullRequestExistById(ctx, pullRequestReassign.Id)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	if !isExist {
 		logger.Error("pull request with id is not exist (ReassignPullRequest)", zap.Error(err), zap.String("pr_id", pullRequestReassign.Id))
-		return nil, "", entity.ErrPullRequestNotExist
+		return nil, entity.ErrPullRequestNotExist
 	}
 
 	isOldReviewerExist, err := u.UserRepository.CheckUserExistById(ctx, pullRequestReassign.OldReviewerId)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	if !isOldReviewerExist {
-		return nil, "", entity.ErrUserNotFound
+		return nil, entity.ErrUserNotFound
 	}
 
 	isMerged, err := u.PRRepository.CheckPullRequestIsMergedById(ctx, pullRequestReassign.Id)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	if isMerged {
-		return nil, "", entity.ErrRequestAlreadyMerged
+		return nil, entity.ErrRequestAlreadyMerged
 	}
 
 	reviewers, err := u.PRRepository.GetReviewersByPrId(ctx, pullRequestReassign.Id)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	isAssigned := false
 	for _, r := range reviewers {
@@ -178,37 +394,97 @@ func (u *usecase) ReassignPullRequest(ctx context.Context, pullRequestReassign *
 	}
 	if !isAssigned {
 		logger.Info("old reviewer is not assigned to PR (ReassignPullRequest)", zap.String("pr_id", pullRequestReassign.Id), zap.String("old_reviewer_id", pullRequestReassign.OldReviewerId))
-		return nil, "", entity.ErrUserNotFound
+		return nil, entity.ErrUserNotFound
 	}
 
-	authorId, err := u.PRRepository.GetAuthorIdByPRId(ctx, pullRequestReassign.Id)
-	if err != nil {
-		return nil, "", err
-	}
+	newReviewerId := pullRequestReassign.NewReviewerId
+	if newReviewerId == "" {
+		authorId, err := u.PRRepository.GetAuthorIdByPRId(ctx, pullRequestReassign.Id)
+		if err != nil {
+			return nil, err
+		}
 
-	newReviewerId, err := u.UserRepository.FindNewReviewer(ctx, pullRequestReassign.Id, authorId, pullRequestReassign.OldReviewerId)
-	if err != nil {
-		return nil, "", err
+		newReviewerId, err = u.UserRepository.FindNewReviewer(ctx, pullRequestReassign.Id, authorId, pullRequestReassign.OldReviewerId)
+		if err != nil {
+			return nil, err
+		}
+
+		if newReviewerId == "" {
+			return u.noCandidateResult(ctx, pullRequestReassign.Id, authorId)
+		}
 	}
 
-	if newReviewerId == "" {
-		logger.Info("no available reviewer (ReassignPullRequest)")
-		pullRequest, err := u.GetPullRequestById(ctx, pullRequestReassign.Id)
+	err = u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if pullRequestReassign.NewReviewerId != "" {
+			// выбранный ревьювер проверяется под той же блокировкой pull request'а, что и в AddReviewer,
+			// чтобы параллельные назначения не обошли лимит открытых ревью и состав ревьюверов
+			if _, err := u.PRRepository.GetReviewerLimitsForUpdate(ctx, pullRequestReassign.Id); err != nil {
+				return err
+			}
+
+			reviewers, err := u.PRRepository.GetReviewersByPrId(ctx, pullRequestReassign.Id)
+			if err != nil {
+				return err
+			}
+
+			if !slices.Contains(reviewers, pullRequestReassign.OldReviewerId) {
+				return entity.ErrUserNotFound
+			}
+
+			if err := u.checkReviewerEligible(ctx, pullRequestReassign.Id, newReviewerId, reviewers); err != nil {
+				return err
+			}
+		}
+
+		err := u.PRRepository.UpdateReviewerId(ctx, pullRequestReassign.Id, pullRequestReassign.OldReviewerId, newReviewerId)
 		if err != nil {
-			return nil, "", err
+			return err
 		}
-		return pullRequest, "", nil
+		return u.publish(ctx, &entity.DomainEvent{
+			Type:          entity.EventTypeReviewerReassigned,
+			PullRequestId: pullRequestReassign.Id,
+			ReviewerId:    newReviewerId,
+			OldReviewerId: pullRequestReassign.OldReviewerId,
+		})
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	err = u.PRRepository.UpdateReviewerId(ctx, pullRequestReassign.Id, pullRequestReassign.OldReviewerId, newReviewerId)
+	pullRequest, err := u.GetPullRequestById(ctx, pullRequestReassign.Id)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	pullRequest, err := u.GetPullRequestById(ctx, pullRequestReassign.Id)
+	return &entity.PullRequestReassignResult{
+		PullRequest: pullRequest,
+		ReplacedBy:  newReviewerId,
+		Outcome:     entity.ReassignmentReassigned,
+	}, nil
+}
+
+// noCandidateResult оставляет ревьюверов без изменений и объясняет, почему замена не нашлась.
+func (u *usecase) noCandidateResult(ctx context.Context, prId, authorId string) (*entity.PullRequestReassignResult, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	exclusions, err := u.UserRepository.ExplainReviewerCandidates(ctx, prId, authorId)
 	if err != nil {
-		return nil, "", err
+		return nil, err
+	}
+	logger.Info("no available reviewer (ReassignPullRequest)",
+		zap.String("pr_id", prId),
+		zap.Int("inactive", exclusions.Inactive),
+		zap.Int("already_assigned", exclusions.AlreadyAssigned),
+		zap.Int("at_capacity", exclusions.AtCapacity))
+
+	pullRequest, err := u.GetPullRequestById(ctx, prId)
+	if err != nil {
+		return nil, err
 	}
 
-	return pullRequest, newReviewerId, nil
+	return &entity.PullRequestReassignResult{
+		PullRequest:        pullRequest,
+		Outcome:            entity.ReassignmentNoCandidate,
+		ExcludedCandidates: exclusions,
+	}, nil
 }
@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/Mockird31/avito_tech/internal/entity"
 	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	mock_outbox "github.com/Mockird31/avito_tech/mocks/outbox"
 	mock_pullrequest "github.com/Mockird31/avito_tech/mocks/pullrequest"
 	mock_team "github.com/Mockird31/avito_tech/mocks/team"
 	mock_user "github.com/Mockird31/avito_tech/mocks/user"
@@ -17,12 +19,26 @@ import (
 )
 
 func setupTest(t *testing.T) (pullrequest.IUsecase, *mock_team.MockIRepository, *mock_user.MockIRepository, *mock_pullrequest.MockIRepository) {
+	prUsecase, teamRepo, userRepo, prRepo, outboxRepo := setupTestWithOutbox(t)
+	outboxRepo.EXPECT().AddEvents(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return prUsecase, teamRepo, userRepo, prRepo
+}
+
+func setupTestWithOutbox(t *testing.T) (pullrequest.IUsecase, *mock_team.MockIRepository, *mock_user.MockIRepository, *mock_pullrequest.MockIRepository, *mock_outbox.MockIRepository) {
 	teamRepo := mock_team.NewMockIRepository(t)
 	userRepo := mock_user.NewMockIRepository(t)
 	prRepo := mock_pullrequest.NewMockIRepository(t)
-
-	prUsecase := NewUsecase(prRepo, userRepo, teamRepo)
-	return prUsecase, teamRepo, userRepo, prRepo
+	outboxRepo := mock_outbox.NewMockIRepository(t)
+	transactor := mock_outbox.NewMockITransactor(t)
+	transactor.EXPECT().
+		WithinTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+
+	prUsecase := NewUsecase(prRepo, userRepo, teamRepo, outboxRepo, transactor)
+	return prUsecase, teamRepo, userRepo, prRepo, outboxRepo
 }
 
 func getTestContext() context.Context {
@@ -99,6 +115,11 @@ func TestGetPullRequestById_ReviewersError(t *testing.T) {
 	assert.Nil(t, got)
 }
 
+// newPullRequest - pull request, который usecase передает в репозиторий для запроса без метаданных.
+func newPullRequest(prId, prName, authorId string) *entity.PullRequest {
+	return &entity.PullRequest{Id: prId, PrName: prName, AuthorId: authorId, Labels: []string{}, Priority: entity.DefaultPriority}
+}
+
 func TestCreatePullRequest_Success_WithReviewers(t *testing.T) {
 	uc, teamRepo, userRepo, prRepo := setupTest(t)
 	ctx := getTestContext()
@@ -122,7 +143,7 @@ func TestCreatePullRequest_Success_WithReviewers(t *testing.T) {
 		CheckTeamNameExist(mock.Anything, "teamA").
 		Return(true, nil)
 	prRepo.EXPECT().
-		CreatePullRequest(mock.Anything, prId, prName, authorId).
+		CreatePullRequest(mock.Anything, newPullRequest(prId, prName, authorId)).
 		Return(nil)
 	userRepo.EXPECT().
 		FindReviewers(mock.Anything, authorId).
@@ -165,11 +186,14 @@ func TestCreatePullRequest_Success_NoReviewers(t *testing.T) {
 		CheckTeamNameExist(mock.Anything, "teamB").
 		Return(true, nil)
 	prRepo.EXPECT().
-		CreatePullRequest(mock.Anything, prId, prName, authorId).
+		CreatePullRequest(mock.Anything, newPullRequest(prId, prName, authorId)).
 		Return(nil)
 	userRepo.EXPECT().
 		FindReviewers(mock.Anything, authorId).
 		Return([]string{}, nil)
+	userRepo.EXPECT().
+		CountCandidatesAtCapacity(mock.Anything, authorId).
+		Return(0, nil)
 
 	req := &entity.PullRequest{Id: prId, PrName: prName, AuthorId: authorId}
 	got, err := uc.CreatePullRequest(ctx, req)
@@ -177,10 +201,138 @@ func TestCreatePullRequest_Success_NoReviewers(t *testing.T) {
 	require.NotNil(t, got)
 
 	assert.Equal(t, []string{}, got.AssignedReviewersIds)
+	assert.Equal(t, &entity.ReviewerShortfall{
+		Required: entity.RequiredReviewersCount,
+		Assigned: 0,
+		Reason:   entity.ShortfallReasonNoCandidates,
+	}, got.ReviewerShortfall)
 
 	prRepo.AssertNotCalled(t, "ConnectReviewersWithPullRequest", mock.Anything, mock.Anything, mock.Anything)
 }
 
+func TestCreatePullRequest_PublishesCreatedAndAssignedEvents(t *testing.T) {
+	uc, teamRepo, userRepo, prRepo, outboxRepo := setupTestWithOutbox(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().CheckPullRequestExistById(mock.Anything, "pr1").Return(false, nil)
+	userRepo.EXPECT().CheckUserExistById(mock.Anything, "u1").Return(true, nil)
+	userRepo.EXPECT().GetUserById(mock.Anything, "u1").Return(&entity.User{UserId: "u1", TeamName: "teamA"}, nil)
+	teamRepo.EXPECT().CheckTeamNameExist(mock.Anything, "teamA").Return(true, nil)
+	prRepo.EXPECT().CreatePullRequest(mock.Anything, newPullRequest("pr1", "Feature", "u1")).Return(nil)
+	userRepo.EXPECT().FindReviewers(mock.Anything, "u1").Return([]string{"r1"}, nil)
+	userRepo.EXPECT().CountCandidatesAtCapacity(mock.Anything, "u1").Return(0, nil).Maybe()
+	prRepo.EXPECT().ConnectReviewersWithPullRequest(mock.Anything, "pr1", []string{"r1"}).Return(nil)
+	outboxRepo.EXPECT().
+		AddEvents(mock.Anything, mock.MatchedBy(func(events []*entity.DomainEvent) bool {
+			return len(events) == 2 &&
+				events[0].Type == entity.EventTypePullRequestCreated && events[0].PullRequestId == "pr1" &&
+				events[1].Type == entity.EventTypeReviewerAssigned && events[1].ReviewerId == "r1"
+		})).
+		Return(nil).
+		Once()
+
+	_, err := uc.CreatePullRequest(ctx, &entity.PullRequest{Id: "pr1", PrName: "Feature", AuthorId: "u1"})
+	require.NoError(t, err)
+}
+
+func TestCreatePullRequest_PersistsMetadata(t *testing.T) {
+	uc, teamRepo, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	req := &entity.PullRequest{
+		Id:          "pr1",
+		PrName:      "Feature",
+		AuthorId:    "u1",
+		Description: "Adds search",
+		Labels:      []string{"backend", "search"},
+		Priority:    entity.PriorityHigh,
+	}
+
+	prRepo.EXPECT().CheckPullRequestExistById(mock.Anything, "pr1").Return(false, nil)
+	userRepo.EXPECT().CheckUserExistById(mock.Anything, "u1").Return(true, nil)
+	userRepo.EXPECT().GetUserById(mock.Anything, "u1").Return(&entity.User{UserId: "u1", TeamName: "teamA"}, nil)
+	teamRepo.EXPECT().CheckTeamNameExist(mock.Anything, "teamA").Return(true, nil)
+	prRepo.EXPECT().CreatePullRequest(mock.Anything, req).Return(nil)
+	userRepo.EXPECT().FindReviewers(mock.Anything, "u1").Return([]string{"r1", "r2"}, nil)
+	prRepo.EXPECT().ConnectReviewersWithPullRequest(mock.Anything, "pr1", []string{"r1", "r2"}).Return(nil)
+
+	got, err := uc.CreatePullRequest(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, "Adds search", got.Description)
+	assert.Equal(t, []string{"backend", "search"}, got.Labels)
+	assert.Equal(t, entity.PriorityHigh, got.Priority)
+}
+
+func TestCreatePullRequest_InvalidMetadata(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *entity.PullRequest
+		want error
+	}{
+		{"unknown priority", &entity.PullRequest{Id: "pr1", PrName: "Feature", AuthorId: "u1", Priority: "URGENT"}, entity.ErrInvalidPriority},
+		{"empty label", &entity.PullRequest{Id: "pr1", PrName: "Feature", AuthorId: "u1", Labels: []string{""}}, entity.ErrInvalidLabels},
+		{"too many labels", &entity.PullRequest{Id: "pr1", PrName: "Feature", AuthorId: "u1", Labels: make([]string, entity.MaxLabelsCount+1)}, entity.ErrInvalidLabels},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, _, _ := setupTest(t)
+
+			got, err := uc.CreatePullRequest(getTestContext(), tt.req)
+			require.ErrorIs(t, err, tt.want)
+			assert.Nil(t, got)
+		})
+	}
+}
+
+func TestCreatePullRequest_Shortfall_AtCapacity(t *testing.T) {
+	uc, teamRepo, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	prId := "pr-busy"
+	prName := "Busy team"
+	authorId := "u3"
+	author := &entity.User{UserId: authorId, TeamName: "teamC"}
+	reviewers := []string{"r1"}
+
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, prId).
+		Return(false, nil)
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, authorId).
+		Return(true, nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, authorId).
+		Return(author, nil)
+	teamRepo.EXPECT().
+		CheckTeamNameExist(mock.Anything, "teamC").
+		Return(true, nil)
+	prRepo.EXPECT().
+		CreatePullRequest(mock.Anything, newPullRequest(prId, prName, authorId)).
+		Return(nil)
+	userRepo.EXPECT().
+		FindReviewers(mock.Anything, authorId).
+		Return(reviewers, nil)
+	prRepo.EXPECT().
+		ConnectReviewersWithPullRequest(mock.Anything, prId, reviewers).
+		Return(nil)
+	userRepo.EXPECT().
+		CountCandidatesAtCapacity(mock.Anything, authorId).
+		Return(3, nil)
+
+	req := &entity.PullRequest{Id: prId, PrName: prName, AuthorId: authorId}
+	got, err := uc.CreatePullRequest(ctx, req)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, reviewers, got.AssignedReviewersIds)
+	assert.Equal(t, &entity.ReviewerShortfall{
+		Required:   entity.RequiredReviewersCount,
+		Assigned:   1,
+		AtCapacity: 3,
+		Reason:     entity.ShortfallReasonAtCapacity,
+	}, got.ReviewerShortfall)
+}
+
 func TestCreatePullRequest_AlreadyExists(t *testing.T) {
 	uc, _, userRepo, prRepo := setupTest(t)
 	ctx := getTestContext()
@@ -360,7 +512,7 @@ func TestCreatePullRequest_Create_Error(t *testing.T) {
 		CheckTeamNameExist(mock.Anything, "teamZ").
 		Return(true, nil)
 	prRepo.EXPECT().
-		CreatePullRequest(mock.Anything, prId, prName, authorId).
+		CreatePullRequest(mock.Anything, newPullRequest(prId, prName, authorId)).
 		Return(assert.AnError)
 
 	req := &entity.PullRequest{Id: prId, PrName: prName, AuthorId: authorId}
@@ -391,7 +543,7 @@ func TestCreatePullRequest_FindReviewers_Error(t *testing.T) {
 		CheckTeamNameExist(mock.Anything, "teamA").
 		Return(true, nil)
 	prRepo.EXPECT().
-		CreatePullRequest(mock.Anything, prId, prName, authorId).
+		CreatePullRequest(mock.Anything, newPullRequest(prId, prName, authorId)).
 		Return(nil)
 	userRepo.EXPECT().
 		FindReviewers(mock.Anything, authorId).
@@ -426,7 +578,7 @@ func TestCreatePullRequest_ConnectReviewers_Error(t *testing.T) {
 		CheckTeamNameExist(mock.Anything, "teamA").
 		Return(true, nil)
 	prRepo.EXPECT().
-		CreatePullRequest(mock.Anything, prId, prName, authorId).
+		CreatePullRequest(mock.Anything, newPullRequest(prId, prName, authorId)).
 		Return(nil)
 	userRepo.EXPECT().
 		FindReviewers(mock.Anything, authorId).
@@ -440,3 +592,763 @@ func TestCreatePullRequest_ConnectReviewers_Error(t *testing.T) {
 	require.Error(t, err)
 	assert.Nil(t, got)
 }
+
+func TestListPullRequests_InvalidFilter(t *testing.T) {
+	uc, _, _, _ := setupTest(t)
+	ctx := getTestContext()
+
+	got, err := uc.ListPullRequests(ctx, &entity.PullRequestFilter{Order: "sideways"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrInvalidFilter)
+}
+
+func TestListPullRequests_Success(t *testing.T) {
+	uc, _, _, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	filter := &entity.PullRequestFilter{TeamName: "teamA", NameSearch: "fix", Limit: 1}
+	prs := []*entity.PullRequestShort{
+		{Id: "pr2", PrName: "Fix login"},
+		{Id: "pr1", PrName: "Fix logout"},
+	}
+
+	prRepo.EXPECT().
+		ListPullRequests(mock.Anything, &entity.PullRequestFilter{TeamName: "teamA", NameSearch: "fix", Order: entity.SortOrderDesc, Limit: 2}).
+		Return(prs, nil)
+	prRepo.EXPECT().
+		CountPullRequests(mock.Anything, &entity.PullRequestFilter{TeamName: "teamA", NameSearch: "fix", Order: entity.SortOrderDesc, Limit: 1}).
+		Return(2, nil)
+
+	got, err := uc.ListPullRequests(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, prs[:1], got.PullRequests)
+	assert.Equal(t, 2, got.Total)
+	assert.NotEmpty(t, got.NextCursor)
+}
+
+func TestUpdatePullRequest_NothingToUpdate(t *testing.T) {
+	uc, _, _, _ := setupTest(t)
+	ctx := getTestContext()
+
+	got, err := uc.UpdatePullRequest(ctx, &entity.PullRequestUpdate{Id: "pr1", Version: 1})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrNothingToUpdate)
+}
+
+func TestUpdatePullRequest_InvalidPriority(t *testing.T) {
+	uc, _, _, _ := setupTest(t)
+	ctx := getTestContext()
+
+	priority := "ASAP"
+	got, err := uc.UpdatePullRequest(ctx, &entity.PullRequestUpdate{Id: "pr1", Version: 1, Priority: &priority})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrInvalidPriority)
+}
+
+func TestUpdatePullRequest_NotExist(t *testing.T) {
+	uc, _, _, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	name := "Renamed"
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(false, nil)
+
+	got, err := uc.UpdatePullRequest(ctx, &entity.PullRequestUpdate{Id: "pr1", Version: 1, PrName: &name})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrPullRequestNotExist)
+}
+
+func TestUpdatePullRequest_VersionConflict(t *testing.T) {
+	uc, _, _, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	name := "Renamed"
+	update := &entity.PullRequestUpdate{Id: "pr1", Version: 1, PrName: &name}
+
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		UpdatePullRequest(mock.Anything, update).
+		Return(entity.ErrVersionConflict)
+
+	got, err := uc.UpdatePullRequest(ctx, update)
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrVersionConflict)
+}
+
+func TestUpdatePullRequest_Success(t *testing.T) {
+	uc, _, _, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	priority := entity.PriorityHigh
+	update := &entity.PullRequestUpdate{Id: "pr1", Version: 1, Labels: []string{"backend"}, Priority: &priority}
+	updated := &entity.PullRequest{Id: "pr1", Labels: []string{"backend"}, Priority: entity.PriorityHigh, Version: 2}
+
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		UpdatePullRequest(mock.Anything, update).
+		Return(nil)
+	prRepo.EXPECT().
+		GetPullRequestById(mock.Anything, "pr1").
+		Return(updated, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, "pr1").
+		Return([]string{"r1"}, nil)
+
+	got, err := uc.UpdatePullRequest(ctx, update)
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Version)
+	assert.Equal(t, []string{"r1"}, got.AssignedReviewersIds)
+}
+
+var defaultReviewerLimits = &entity.TeamReviewerLimits{
+	TeamName:     "teamA",
+	MinReviewers: entity.DefaultMinReviewersCount,
+	MaxReviewers: entity.DefaultMaxReviewersCount,
+}
+
+func expectOpenPullRequest(prRepo *mock_pullrequest.MockIRepository, prId string, reviewers []string, limits *entity.TeamReviewerLimits) {
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, prId).
+		Return(true, nil)
+	prRepo.EXPECT().
+		CheckPullRequestIsMergedById(mock.Anything, prId).
+		Return(false, nil)
+	prRepo.EXPECT().
+		GetReviewerLimitsForUpdate(mock.Anything, prId).
+		Return(limits, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, prId).
+		Return(reviewers, nil).
+		Once()
+}
+
+func TestAddReviewer_Merged(t *testing.T) {
+	uc, _, _, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		CheckPullRequestIsMergedById(mock.Anything, "pr1").
+		Return(true, nil)
+
+	got, err := uc.AddReviewer(ctx, &entity.PullRequestReviewerChange{Id: "pr1", ReviewerId: "u3"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrPullRequestMerged)
+}
+
+func TestAddReviewer_NotInTeam(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectOpenPullRequest(prRepo, "pr1", []string{"u2"}, defaultReviewerLimits)
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "x1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "x1").
+		Return(&entity.User{UserId: "x1", TeamName: "teamB", IsActive: true}, nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u1").
+		Return(&entity.User{UserId: "u1", TeamName: "teamA", IsActive: true}, nil)
+
+	got, err := uc.AddReviewer(ctx, &entity.PullRequestReviewerChange{Id: "pr1", ReviewerId: "x1"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrReviewerNotInTeam)
+}
+
+func TestAddReviewer_AlreadyAssigned(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectOpenPullRequest(prRepo, "pr1", []string{"u2"}, defaultReviewerLimits)
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u2").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+
+	got, err := uc.AddReviewer(ctx, &entity.PullRequestReviewerChange{Id: "pr1", ReviewerId: "u2"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrReviewerAlreadyAssigned)
+}
+
+func TestAddReviewer_AtCapacity(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectOpenPullRequest(prRepo, "pr1", []string{"u2"}, defaultReviewerLimits)
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u3").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u3").
+		Return(&entity.User{UserId: "u3", TeamName: "teamA", IsActive: true}, nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u1").
+		Return(&entity.User{UserId: "u1", TeamName: "teamA", IsActive: true}, nil)
+	userRepo.EXPECT().
+		CheckBelowReviewCapacity(mock.Anything, "u3").
+		Return(false, nil)
+
+	got, err := uc.AddReviewer(ctx, &entity.PullRequestReviewerChange{Id: "pr1", ReviewerId: "u3"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrReviewerAtCapacity)
+}
+
+func TestAddReviewer_Success(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectOpenPullRequest(prRepo, "pr1", []string{"u2"}, defaultReviewerLimits)
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u3").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u3").
+		Return(&entity.User{UserId: "u3", TeamName: "teamA", IsActive: true}, nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u1").
+		Return(&entity.User{UserId: "u1", TeamName: "teamA", IsActive: true}, nil)
+	userRepo.EXPECT().
+		CheckBelowReviewCapacity(mock.Anything, "u3").
+		Return(true, nil)
+	prRepo.EXPECT().
+		ConnectReviewersWithPullRequest(mock.Anything, "pr1", []string{"u3"}).
+		Return(nil)
+	prRepo.EXPECT().
+		RecordEvent(mock.Anything, &entity.PullRequestEvent{PullRequestId: "pr1", EventType: entity.EventReviewerAdded, ReviewerId: "u3"}).
+		Return(nil)
+	prRepo.EXPECT().
+		GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", AuthorId: "u1", Status: "OPEN"}, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, "pr1").
+		Return([]string{"u2", "u3"}, nil)
+
+	got, err := uc.AddReviewer(ctx, &entity.PullRequestReviewerChange{Id: "pr1", ReviewerId: "u3"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2", "u3"}, got.AssignedReviewersIds)
+}
+
+func TestAddReviewer_TeamMaximumReached(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectOpenPullRequest(prRepo, "pr1", []string{"u2"}, &entity.TeamReviewerLimits{TeamName: "teamA", MinReviewers: 0, MaxReviewers: 1})
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u3").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u3").
+		Return(&entity.User{UserId: "u3", TeamName: "teamA", IsActive: true}, nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u1").
+		Return(&entity.User{UserId: "u1", TeamName: "teamA", IsActive: true}, nil)
+	userRepo.EXPECT().
+		CheckBelowReviewCapacity(mock.Anything, "u3").
+		Return(true, nil)
+
+	got, err := uc.AddReviewer(ctx, &entity.PullRequestReviewerChange{Id: "pr1", ReviewerId: "u3"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrTooManyReviewers)
+}
+
+func TestRemoveReviewer_NotAssigned(t *testing.T) {
+	uc, _, _, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectOpenPullRequest(prRepo, "pr1", []string{"u2"}, defaultReviewerLimits)
+
+	got, err := uc.RemoveReviewer(ctx, &entity.PullRequestReviewerChange{Id: "pr1", ReviewerId: "u3"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrReviewerNotAssigned)
+}
+
+func TestRemoveReviewer_BelowTeamMinimum(t *testing.T) {
+	uc, _, _, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectOpenPullRequest(prRepo, "pr1", []string{"u2", "u3"}, &entity.TeamReviewerLimits{TeamName: "teamA", MinReviewers: 2, MaxReviewers: 5})
+
+	got, err := uc.RemoveReviewer(ctx, &entity.PullRequestReviewerChange{Id: "pr1", ReviewerId: "u3"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrTooFewReviewers)
+}
+
+func TestRemoveReviewer_Success(t *testing.T) {
+	uc, _, _, prRepo, outboxRepo := setupTestWithOutbox(t)
+	ctx := getTestContext()
+
+	expectOpenPullRequest(prRepo, "pr1", []string{"u2", "u3"}, defaultReviewerLimits)
+	prRepo.EXPECT().
+		RemoveReviewer(mock.Anything, "pr1", "u3").
+		Return(nil)
+	prRepo.EXPECT().
+		RecordEvent(mock.Anything, &entity.PullRequestEvent{PullRequestId: "pr1", EventType: entity.EventReviewerRemoved, ReviewerId: "u3"}).
+		Return(nil)
+	outboxRepo.EXPECT().
+		AddEvents(mock.Anything, mock.MatchedBy(func(events []*entity.DomainEvent) bool {
+			return len(events) == 1 && events[0].Type == entity.EventTypeReviewerRemoved && events[0].ReviewerId == "u3"
+		})).
+		Return(nil)
+	prRepo.EXPECT().
+		GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", AuthorId: "u1", Status: "OPEN"}, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, "pr1").
+		Return([]string{"u2"}, nil)
+
+	got, err := uc.RemoveReviewer(ctx, &entity.PullRequestReviewerChange{Id: "pr1", ReviewerId: "u3"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, got.AssignedReviewersIds)
+}
+
+func expectReassignablePullRequest(prRepo *mock_pullrequest.MockIRepository, userRepo *mock_user.MockIRepository, prId, oldReviewerId string, reviewers []string) {
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, prId).
+		Return(true, nil)
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, oldReviewerId).
+		Return(true, nil)
+	prRepo.EXPECT().
+		CheckPullRequestIsMergedById(mock.Anything, prId).
+		Return(false, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, prId).
+		Return(reviewers, nil).
+		Once()
+}
+
+// expectReassignLock - проверка выбранного ревьювера внутри транзакции под блокировкой pull request'а.
+func expectReassignLock(prRepo *mock_pullrequest.MockIRepository, prId string, reviewers []string) {
+	prRepo.EXPECT().
+		GetReviewerLimitsForUpdate(mock.Anything, prId).
+		Return(defaultReviewerLimits, nil).
+		Once()
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, prId).
+		Return(reviewers, nil).
+		Once()
+}
+
+func TestReassignPullRequest_NewReviewerInactive(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectReassignablePullRequest(prRepo, userRepo, "pr1", "u2", []string{"u2"})
+	expectReassignLock(prRepo, "pr1", []string{"u2"})
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u3").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u3").
+		Return(&entity.User{UserId: "u3", TeamName: "teamA", IsActive: false}, nil)
+
+	got, err := uc.ReassignPullRequest(ctx, &entity.PullRequestReassignRequest{Id: "pr1", OldReviewerId: "u2", NewReviewerId: "u3"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrReviewerInactive)
+}
+
+func TestReassignPullRequest_NewReviewerIsAuthor(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectReassignablePullRequest(prRepo, userRepo, "pr1", "u2", []string{"u2"})
+	expectReassignLock(prRepo, "pr1", []string{"u2"})
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+
+	_, err := uc.ReassignPullRequest(ctx, &entity.PullRequestReassignRequest{Id: "pr1", OldReviewerId: "u2", NewReviewerId: "u1"})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, entity.ErrReviewerIsAuthor)
+}
+
+func TestReassignPullRequest_NewReviewerSuccess(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectReassignablePullRequest(prRepo, userRepo, "pr1", "u2", []string{"u2"})
+	expectReassignLock(prRepo, "pr1", []string{"u2"})
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u3").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u3").
+		Return(&entity.User{UserId: "u3", TeamName: "teamA", IsActive: true}, nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u1").
+		Return(&entity.User{UserId: "u1", TeamName: "teamA", IsActive: true}, nil)
+	userRepo.EXPECT().
+		CheckBelowReviewCapacity(mock.Anything, "u3").
+		Return(true, nil)
+	prRepo.EXPECT().
+		UpdateReviewerId(mock.Anything, "pr1", "u2", "u3").
+		Return(nil)
+	prRepo.EXPECT().
+		GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", AuthorId: "u1", Status: "OPEN"}, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, "pr1").
+		Return([]string{"u3"}, nil)
+
+	got, err := uc.ReassignPullRequest(ctx, &entity.PullRequestReassignRequest{Id: "pr1", OldReviewerId: "u2", NewReviewerId: "u3"})
+	require.NoError(t, err)
+	assert.Equal(t, "u3", got.ReplacedBy)
+	assert.Equal(t, entity.ReassignmentReassigned, got.Outcome)
+	assert.Nil(t, got.ExcludedCandidates)
+	assert.Equal(t, []string{"u3"}, got.PullRequest.AssignedReviewersIds)
+}
+
+func TestReassignPullRequest_NewReviewer_OldReviewerRemovedConcurrently(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectReassignablePullRequest(prRepo, userRepo, "pr1", "u2", []string{"u2"})
+	expectReassignLock(prRepo, "pr1", []string{"u4"})
+
+	got, err := uc.ReassignPullRequest(ctx, &entity.PullRequestReassignRequest{Id: "pr1", OldReviewerId: "u2", NewReviewerId: "u3"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrUserNotFound)
+}
+
+func TestReassignPullRequest_NoCandidate(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	expectReassignablePullRequest(prRepo, userRepo, "pr1", "u2", []string{"u2", "u3"})
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+	userRepo.EXPECT().
+		FindNewReviewer(mock.Anything, "pr1", "u1", "u2").
+		Return("", nil)
+	exclusions := &entity.CandidateExclusions{Author: 1, Inactive: 1, AlreadyAssigned: 2, AtCapacity: 1}
+	userRepo.EXPECT().
+		ExplainReviewerCandidates(mock.Anything, "pr1", "u1").
+		Return(exclusions, nil)
+	prRepo.EXPECT().
+		GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", AuthorId: "u1", Status: "OPEN"}, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, "pr1").
+		Return([]string{"u2", "u3"}, nil)
+
+	got, err := uc.ReassignPullRequest(ctx, &entity.PullRequestReassignRequest{Id: "pr1", OldReviewerId: "u2"})
+	require.NoError(t, err)
+	assert.Empty(t, got.ReplacedBy)
+	assert.Equal(t, entity.ReassignmentNoCandidate, got.Outcome)
+	assert.Equal(t, exclusions, got.ExcludedCandidates)
+	assert.Equal(t, []string{"u2", "u3"}, got.PullRequest.AssignedReviewersIds)
+}
+
+func expectTopUpLock(prRepo *mock_pullrequest.MockIRepository, prId string, locked bool, reviewers []string) {
+	prRepo.EXPECT().
+		LockPullRequestForTopUp(mock.Anything, prId).
+		Return(locked, nil)
+	if !locked {
+		return
+	}
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, prId).
+		Return(reviewers, nil)
+}
+
+func TestReconcileReviewers_TopsUpAndSkips(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		GetUnderReviewedPullRequests(mock.Anything, entity.RequiredReviewersCount).
+		Return([]*entity.UnderReviewedPullRequest{
+			{PullRequestId: "pr1", AuthorId: "u1", ReviewersCount: 0},
+			{PullRequestId: "pr2", AuthorId: "u5", ReviewersCount: 1},
+		}, nil)
+
+	expectTopUpLock(prRepo, "pr1", true, []string{})
+	userRepo.EXPECT().
+		FindNewReviewerExcluding(mock.Anything, "pr1", "u1", []string{}).
+		Return("u2", nil).
+		Once()
+	userRepo.EXPECT().
+		FindNewReviewerExcluding(mock.Anything, "pr1", "u1", []string{}).
+		Return("", nil).
+		Once()
+	prRepo.EXPECT().
+		ConnectReviewersWithPullRequest(mock.Anything, "pr1", []string{"u2"}).
+		Return(nil)
+	prRepo.EXPECT().
+		RecordEvent(mock.Anything, &entity.PullRequestEvent{PullRequestId: "pr1", EventType: entity.EventReviewerAutoAdded, ReviewerId: "u2"}).
+		Return(nil)
+
+	expectTopUpLock(prRepo, "pr2", true, []string{"u6"})
+	userRepo.EXPECT().
+		FindNewReviewerExcluding(mock.Anything, "pr2", "u5", []string{}).
+		Return("", nil)
+
+	got, err := uc.ReconcileReviewers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &entity.ReconcileResult{
+		Checked:  2,
+		Added:    1,
+		ToppedUp: []*entity.ReconcileTopUp{{PullRequestId: "pr1", AddedReviewerIds: []string{"u2"}}},
+	}, got)
+}
+
+func TestReconcileReviewers_SkipsLockedAndToppedUpElsewhere(t *testing.T) {
+	uc, _, _, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		GetUnderReviewedPullRequests(mock.Anything, entity.RequiredReviewersCount).
+		Return([]*entity.UnderReviewedPullRequest{
+			{PullRequestId: "pr1", AuthorId: "u1", ReviewersCount: 0},
+			{PullRequestId: "pr2", AuthorId: "u1", ReviewersCount: 1},
+		}, nil)
+	// pr1 держит другая реплика, pr2 уже добран, пока сверка ждала своей очереди
+	expectTopUpLock(prRepo, "pr1", false, nil)
+	expectTopUpLock(prRepo, "pr2", true, []string{"u2", "u3"})
+
+	got, err := uc.ReconcileReviewers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &entity.ReconcileResult{Checked: 2, ToppedUp: []*entity.ReconcileTopUp{}}, got)
+}
+
+func TestReconcileReviewers_ContinuesAfterError(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		GetUnderReviewedPullRequests(mock.Anything, entity.RequiredReviewersCount).
+		Return([]*entity.UnderReviewedPullRequest{
+			{PullRequestId: "pr1", AuthorId: "u1", ReviewersCount: 1},
+			{PullRequestId: "pr2", AuthorId: "u1", ReviewersCount: 1},
+		}, nil)
+	expectTopUpLock(prRepo, "pr1", true, []string{"u2"})
+	userRepo.EXPECT().
+		FindNewReviewerExcluding(mock.Anything, "pr1", "u1", []string{}).
+		Return("", errors.New("db failure"))
+	expectTopUpLock(prRepo, "pr2", true, []string{"u2"})
+	userRepo.EXPECT().
+		FindNewReviewerExcluding(mock.Anything, "pr2", "u1", []string{}).
+		Return("u3", nil)
+	prRepo.EXPECT().
+		ConnectReviewersWithPullRequest(mock.Anything, "pr2", []string{"u3"}).
+		Return(nil)
+	prRepo.EXPECT().
+		RecordEvent(mock.Anything, &entity.PullRequestEvent{PullRequestId: "pr2", EventType: entity.EventReviewerAutoAdded, ReviewerId: "u3"}).
+		Return(nil)
+
+	got, err := uc.ReconcileReviewers(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, got.Added)
+	assert.Equal(t, "pr2", got.ToppedUp[0].PullRequestId)
+}
+
+func TestEscalateOverdueReviews_MarksAndReassigns(t *testing.T) {
+	uc, _, userRepo, prRepo := setupTest(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		MarkOverdueReviews(mock.Anything).
+		Return([]*entity.OverdueReview{
+			{PullRequestId: "pr1", ReviewerId: "u2", TeamName: "backend", ReviewSlaHours: 24, AutoReassign: true},
+			{PullRequestId: "pr2", ReviewerId: "u3", TeamName: "frontend", ReviewSlaHours: 8},
+		}, nil)
+
+	expectReassignablePullRequest(prRepo, userRepo, "pr1", "u2", []string{"u2"})
+	prRepo.EXPECT().
+		GetAuthorIdByPRId(mock.Anything, "pr1").
+		Return("u1", nil)
+	userRepo.EXPECT().
+		FindNewReviewer(mock.Anything, "pr1", "u1", "u2").
+		Return("u4", nil)
+	prRepo.EXPECT().
+		UpdateReviewerId(mock.Anything, "pr1", "u2", "u4").
+		Return(nil)
+	prRepo.EXPECT().
+		GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", AuthorId: "u1", Status: "OPEN"}, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, "pr1").
+		Return([]string{"u4"}, nil)
+
+	got, err := uc.EscalateOverdueReviews(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, &entity.OverdueSweepResult{Marked: 2, Reassigned: 1}, got)
+}
+
+func TestMergePullRequest_PublishesEvent(t *testing.T) {
+	uc, _, _, prRepo, outboxRepo := setupTestWithOutbox(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		CheckPullRequestIsMergedById(mock.Anything, "pr1").
+		Return(false, nil)
+	prRepo.EXPECT().
+		MergePullRequest(mock.Anything, "pr1").
+		Return(nil)
+	outboxRepo.EXPECT().
+		AddEvents(mock.Anything, mock.MatchedBy(func(events []*entity.DomainEvent) bool {
+			return len(events) == 1 && events[0].Type == entity.EventTypePullRequestMerged && events[0].PullRequestId == "pr1" && !events[0].OccurredAt.IsZero()
+		})).
+		Return(nil).
+		Once()
+	prRepo.EXPECT().
+		GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", AuthorId: "u1", Status: "MERGED"}, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, "pr1").
+		Return([]string{"u2"}, nil)
+
+	got, err := uc.MergePullRequest(ctx, &entity.PullRequest{Id: "pr1"})
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", got.Status)
+}
+
+func TestMergePullRequest_AlreadyMerged_NoEvent(t *testing.T) {
+	uc, _, _, prRepo, _ := setupTestWithOutbox(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		CheckPullRequestIsMergedById(mock.Anything, "pr1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", AuthorId: "u1", Status: "MERGED"}, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, "pr1").
+		Return([]string{"u2"}, nil)
+
+	_, err := uc.MergePullRequest(ctx, &entity.PullRequest{Id: "pr1"})
+	require.NoError(t, err)
+}
+
+func TestClosePullRequest_PublishesEvent(t *testing.T) {
+	uc, _, _, prRepo, outboxRepo := setupTestWithOutbox(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		ClosePullRequest(mock.Anything, "pr1").
+		Return(nil)
+	outboxRepo.EXPECT().
+		AddEvents(mock.Anything, mock.MatchedBy(func(events []*entity.DomainEvent) bool {
+			return len(events) == 1 && events[0].Type == entity.EventTypePullRequestClosed && events[0].PullRequestId == "pr1"
+		})).
+		Return(nil).
+		Once()
+	prRepo.EXPECT().
+		GetPullRequestById(mock.Anything, "pr1").
+		Return(&entity.PullRequest{Id: "pr1", AuthorId: "u1", Status: "CLOSED"}, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrId(mock.Anything, "pr1").
+		Return([]string{"u2"}, nil)
+
+	got, err := uc.ClosePullRequest(ctx, &entity.PullRequest{Id: "pr1"})
+	require.NoError(t, err)
+	assert.Equal(t, "CLOSED", got.Status)
+}
+
+func TestClosePullRequest_NotOpen_NoEvent(t *testing.T) {
+	uc, _, _, prRepo, _ := setupTestWithOutbox(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		ClosePullRequest(mock.Anything, "pr1").
+		Return(entity.ErrPullRequestNotOpen)
+
+	got, err := uc.ClosePullRequest(ctx, &entity.PullRequest{Id: "pr1"})
+	assert.ErrorIs(t, err, entity.ErrPullRequestNotOpen)
+	assert.Nil(t, got)
+}
+
+func TestReopenPullRequest_NotExist(t *testing.T) {
+	uc, _, _, prRepo, _ := setupTestWithOutbox(t)
+	ctx := getTestContext()
+
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(false, nil)
+
+	got, err := uc.ReopenPullRequest(ctx, &entity.PullRequest{Id: "pr1"})
+	assert.ErrorIs(t, err, entity.ErrPullRequestNotExist)
+	assert.Nil(t, got)
+}
+
+func TestMergePullRequest_OutboxError(t *testing.T) {
+	uc, _, _, prRepo, outboxRepo := setupTestWithOutbox(t)
+	ctx := getTestContext()
+
+	dbErr := errors.New("db failure")
+	prRepo.EXPECT().
+		CheckPullRequestExistById(mock.Anything, "pr1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		CheckPullRequestIsMergedById(mock.Anything, "pr1").
+		Return(false, nil)
+	prRepo.EXPECT().
+		MergePullRequest(mock.Anything, "pr1").
+		Return(nil)
+	outboxRepo.EXPECT().
+		AddEvents(mock.Anything, mock.Anything).
+		Return(dbErr)
+
+	got, err := uc.MergePullRequest(ctx, &entity.PullRequest{Id: "pr1"})
+	assert.ErrorIs(t, err, dbErr)
+	assert.Nil(t, got)
+}
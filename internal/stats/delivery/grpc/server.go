@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/grpc/pb"
+	"github.com/Mockird31/avito_tech/internal/stats"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Server struct {
+	pb.UnimplementedStatsServiceServer
+	usecase stats.IUsecase
+}
+
+func NewServer(usecase stats.IUsecase) *Server {
+	return &Server{
+		usecase: usecase,
+	}
+}
+
+func (s *Server) GetAssignmentsStatsByReviewers(ctx context.Context, _ *pb.GetAssignmentsStatsRequest) (*pb.AssignmentsStats, error) {
+	counts, err := s.usecase.GetAssignmentsStatsByReviewers(ctx)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	result := &pb.AssignmentsStats{Stats: make([]*pb.UserAssignmentCount, 0, len(counts))}
+	for _, count := range counts {
+		result.Stats = append(result.Stats, &pb.UserAssignmentCount{
+			UserId: count.UserId,
+			Count:  int32(count.Count),
+		})
+	}
+	return result, nil
+}
+
+func (s *Server) GetOverdueReviews(ctx context.Context, req *pb.GetOverdueReviewsRequest) (*pb.OverdueReviews, error) {
+	overdueReviews, err := s.usecase.GetOverdueReviews(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	result := &pb.OverdueReviews{OverdueReviews: make([]*pb.OverdueReview, 0, len(overdueReviews))}
+	for _, review := range overdueReviews {
+		result.OverdueReviews = append(result.OverdueReviews, &pb.OverdueReview{
+			PullRequestId:  review.PullRequestId,
+			ReviewerId:     review.ReviewerId,
+			TeamName:       review.TeamName,
+			AssignedAt:     timestamppb.New(review.AssignedAt),
+			OverdueAt:      timestamppb.New(review.OverdueAt),
+			ReviewSlaHours: int32(review.ReviewSlaHours),
+		})
+	}
+	return result, nil
+}
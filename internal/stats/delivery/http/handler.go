@@ -29,3 +29,17 @@ func (h *Handler) GetAssignmentsStats(w http.ResponseWriter, r *http.Request) {
 
 	json.WriteJSON(w, http.StatusOK, &entity.AssignmentStatsResponse{Statistics: assignmentStats}, nil)
 }
+
+func (h *Handler) GetOverdueReviews(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	teamName := r.URL.Query().Get("team_name")
+
+	overdueReviews, err := h.statsUsecase.GetOverdueReviews(ctx, teamName)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to get overdue reviews")
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.OverdueReviewsResponse{OverdueReviews: overdueReviews}, nil)
+}
@@ -0,0 +1,33 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	json "github.com/Mockird31/avito_tech/pkg/json"
+)
+
+func (h *Handler) GetAssignmentsStatsV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	assignmentStats, err := h.statsUsecase.GetAssignmentsStatsByReviewers(ctx)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.AssignmentStatsResponse{Statistics: assignmentStats}, nil)
+}
+
+func (h *Handler) GetOverdueReviewsV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	overdueReviews, err := h.statsUsecase.GetOverdueReviews(ctx, r.URL.Query().Get("team_name"))
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.OverdueReviewsResponse{OverdueReviews: overdueReviews}, nil)
+}
@@ -20,6 +20,19 @@ const (
         GROUP BY prr.reviewer_id
         ORDER BY prr.reviewer_id;
     `
+	// GetOverdueReviewsQuery отдает просроченные ревью открытых pull request'ов, пустой $1 - по всем командам.
+	GetOverdueReviewsQuery = `
+        SELECT prr.pull_request_id, prr.reviewer_id, t.name, prr.created_at, prr.overdue_at, t.review_sla_hours
+        FROM pull_request_reviewers prr
+        JOIN pull_request p ON p.id = prr.pull_request_id
+        JOIN "user" a ON a.id = p.author_id
+        JOIN team t ON t.name = a.team_name
+        WHERE p.status = 'OPEN'
+          AND prr.overdue_at IS NOT NULL
+          AND t.review_sla_hours IS NOT NULL
+          AND ($1 = '' OR t.name = $1)
+        ORDER BY prr.created_at, prr.pull_request_id, prr.reviewer_id;
+    `
 )
 
 type repository struct {
@@ -70,3 +83,38 @@ func (r *repository) GetAssignmentsStatsByReviewers(ctx context.Context) ([]*ent
 	}
 	return assignmentsStats, nil
 }
+
+func (r *repository) GetOverdueReviews(ctx context.Context, teamName string) (reviews []*entity.OverdueReview, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, GetOverdueReviewsQuery, teamName)
+	if err != nil {
+		logger.Error("failed to get overdue reviews", zap.Error(err))
+		return nil, err
+	}
+
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	reviews = make([]*entity.OverdueReview, 0)
+	for rows.Next() {
+		var review entity.OverdueReview
+		err := rows.Scan(&review.PullRequestId, &review.ReviewerId, &review.TeamName, &review.AssignedAt, &review.OverdueAt, &review.ReviewSlaHours)
+		if err != nil {
+			logger.Error("failed to scan data", zap.Error(err))
+			return nil, err
+		}
+		reviews = append(reviews, &review)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to pass through rows", zap.Error(err))
+		return nil, err
+	}
+	return reviews, nil
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	sqlmock "github.com/DATA-DOG/go-sqlmock"
 	"github.com/Mockird31/avito_tech/internal/entity"
@@ -98,3 +99,42 @@ func TestGetAssignmentsStatsByReviewers_ScanError(t *testing.T) {
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestGetOverdueReviews_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	assignedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	overdueAt := assignedAt.Add(25 * time.Hour)
+	rows := sqlmock.NewRows([]string{"pull_request_id", "reviewer_id", "name", "created_at", "overdue_at", "review_sla_hours"}).
+		AddRow("pr1", "u2", "backend", assignedAt, overdueAt, 24)
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetOverdueReviewsQuery)).
+		WithArgs("backend").
+		WillReturnRows(rows)
+
+	got, err := repo.GetOverdueReviews(ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.OverdueReview{
+		{PullRequestId: "pr1", ReviewerId: "u2", TeamName: "backend", AssignedAt: assignedAt, OverdueAt: overdueAt, ReviewSlaHours: 24},
+	}, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetOverdueReviews_DBError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetOverdueReviewsQuery)).
+		WithArgs("").
+		WillReturnError(errors.New("db failure"))
+
+	got, err := repo.GetOverdueReviews(ctx, "")
+	require.Error(t, err)
+	assert.Nil(t, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -8,4 +8,5 @@ import (
 
 type IUsecase interface {
 	GetAssignmentsStatsByReviewers(ctx context.Context) ([]*entity.UserAssignmentCount, error)
+	GetOverdueReviews(ctx context.Context, teamName string) ([]*entity.OverdueReview, error)
 }
@@ -24,3 +24,11 @@ func (u *usecase) GetAssignmentsStatsByReviewers(ctx context.Context) ([]*entity
 	}
 	return assignmentsStats, nil
 }
+
+func (u *usecase) GetOverdueReviews(ctx context.Context, teamName string) ([]*entity.OverdueReview, error) {
+	overdueReviews, err := u.statsRepository.GetOverdueReviews(ctx, teamName)
+	if err != nil {
+		return nil, err
+	}
+	return overdueReviews, nil
+}
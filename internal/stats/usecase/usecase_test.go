@@ -60,3 +60,18 @@ func TestGetAssignmentsStatsByReviewers_RepoError(t *testing.T) {
 	assert.Nil(t, got)
 	assert.EqualError(t, err, dbErr.Error())
 }
+
+func TestGetOverdueReviews_Success(t *testing.T) {
+	uc, repo := setupTest(t)
+	ctx := getTestContext()
+
+	want := []*entity.OverdueReview{{PullRequestId: "pr1", ReviewerId: "u2", TeamName: "backend", ReviewSlaHours: 24}}
+
+	repo.EXPECT().
+		GetOverdueReviews(mock.Anything, "backend").
+		Return(want, nil)
+
+	got, err := uc.GetOverdueReviews(ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,164 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/grpc/convert"
+	"github.com/Mockird31/avito_tech/internal/grpc/pb"
+	"github.com/Mockird31/avito_tech/internal/team"
+	"github.com/asaskevich/govalidator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type Server struct {
+	pb.UnimplementedTeamServiceServer
+	usecase team.IUsecase
+}
+
+func NewServer(usecase team.IUsecase) *Server {
+	return &Server{
+		usecase: usecase,
+	}
+}
+
+func (s *Server) AddTeam(ctx context.Context, req *pb.Team) (*pb.Team, error) {
+	addTeam := &entity.Team{
+		TeamName: req.GetTeamName(),
+		Members:  make([]*entity.TeamMember, 0, len(req.GetMembers())),
+	}
+	for _, member := range req.GetMembers() {
+		addTeam.Members = append(addTeam.Members, &entity.TeamMember{
+			UserID:   member.GetUserId(),
+			Username: member.GetUsername(),
+			IsActive: member.GetIsActive(),
+		})
+	}
+
+	if _, err := govalidator.ValidateStruct(addTeam); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resultTeam, err := s.usecase.AddTeam(ctx, addTeam)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return teamToProto(resultTeam), nil
+}
+
+func (s *Server) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.Team, error) {
+	if req.GetTeamName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "team_name is required")
+	}
+
+	resultTeam, err := s.usecase.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return teamToProto(resultTeam), nil
+}
+
+func (s *Server) SetReviewCapacity(ctx context.Context, req *pb.TeamReviewCapacity) (*pb.TeamReviewCapacity, error) {
+	capacity := &entity.TeamReviewCapacity{
+		TeamName:       req.GetTeamName(),
+		MaxOpenReviews: convert.IntPtr(req.MaxOpenReviews),
+	}
+
+	if _, err := govalidator.ValidateStruct(capacity); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.usecase.SetReviewCapacity(ctx, capacity)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.TeamReviewCapacity{
+		TeamName:       result.TeamName,
+		MaxOpenReviews: convert.Int32Ptr(result.MaxOpenReviews),
+	}, nil
+}
+
+func (s *Server) SetReviewSla(ctx context.Context, req *pb.TeamReviewSla) (*pb.TeamReviewSla, error) {
+	sla := &entity.TeamReviewSla{
+		TeamName:       req.GetTeamName(),
+		ReviewSlaHours: convert.IntPtr(req.ReviewSlaHours),
+		AutoReassign:   req.GetAutoReassign(),
+	}
+
+	if _, err := govalidator.ValidateStruct(sla); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.usecase.SetReviewSla(ctx, sla)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.TeamReviewSla{
+		TeamName:       result.TeamName,
+		ReviewSlaHours: convert.Int32Ptr(result.ReviewSlaHours),
+		AutoReassign:   result.AutoReassign,
+	}, nil
+}
+
+func (s *Server) SetReviewerLimits(ctx context.Context, req *pb.TeamReviewerLimits) (*pb.TeamReviewerLimits, error) {
+	limits := &entity.TeamReviewerLimits{
+		TeamName:     req.GetTeamName(),
+		MinReviewers: int(req.GetMinReviewers()),
+		MaxReviewers: int(req.GetMaxReviewers()),
+	}
+
+	if _, err := govalidator.ValidateStruct(limits); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.usecase.SetReviewerLimits(ctx, limits)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.TeamReviewerLimits{
+		TeamName:     result.TeamName,
+		MinReviewers: int32(result.MinReviewers),
+		MaxReviewers: int32(result.MaxReviewers),
+	}, nil
+}
+
+func (s *Server) ListTeams(ctx context.Context, _ *pb.ListTeamsRequest) (*pb.TeamList, error) {
+	teams, err := s.usecase.ListTeams(ctx)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	result := &pb.TeamList{Teams: make([]*pb.TeamSummary, 0, len(teams))}
+	for _, t := range teams {
+		result.Teams = append(result.Teams, &pb.TeamSummary{
+			TeamName:       t.TeamName,
+			Members:        int32(t.Members),
+			ActiveMembers:  int32(t.ActiveMembers),
+			MaxOpenReviews: convert.Int32Ptr(t.MaxOpenReviews),
+			ReviewSlaHours: convert.Int32Ptr(t.ReviewSlaHours),
+			AutoReassign:   t.AutoReassign,
+			MinReviewers:   int32(t.MinReviewers),
+			MaxReviewers:   int32(t.MaxReviewers),
+		})
+	}
+	return result, nil
+}
+
+func teamToProto(t *entity.Team) *pb.Team {
+	members := make([]*pb.TeamMember, 0, len(t.Members))
+	for _, member := range t.Members {
+		members = append(members, &pb.TeamMember{
+			UserId:   member.UserID,
+			Username: member.Username,
+			IsActive: member.IsActive,
+		})
+	}
+	return &pb.Team{TeamName: t.TeamName, Members: members}
+}
@@ -0,0 +1,95 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/grpc/pb"
+	mock_team "github.com/Mockird31/avito_tech/mocks/team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_AddTeam(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *pb.Team
+		mockSetup func(m *mock_team.MockIUsecase)
+		wantCode  codes.Code
+		wantTeam  *pb.Team
+	}{
+		{
+			name:      "team_name_too_long",
+			req:       &pb.Team{TeamName: strings.Repeat("a", 129)},
+			mockSetup: func(m *mock_team.MockIUsecase) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "team_exists",
+			req:  &pb.Team{TeamName: "alpha"},
+			mockSetup: func(m *mock_team.MockIUsecase) {
+				m.EXPECT().
+					AddTeam(mock.Anything, mock.AnythingOfType("*entity.Team")).
+					Return(nil, entity.ErrTeamNameExist)
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name: "success",
+			req: &pb.Team{TeamName: "alpha", Members: []*pb.TeamMember{
+				{UserId: "u1", Username: "alice", IsActive: true},
+			}},
+			mockSetup: func(m *mock_team.MockIUsecase) {
+				m.EXPECT().
+					AddTeam(mock.Anything, &entity.Team{TeamName: "alpha", Members: []*entity.TeamMember{
+						{UserID: "u1", Username: "alice", IsActive: true},
+					}}).
+					Return(&entity.Team{TeamName: "alpha", Members: []*entity.TeamMember{
+						{UserID: "u1", Username: "alice", IsActive: true},
+					}}, nil)
+			},
+			wantCode: codes.OK,
+			wantTeam: &pb.Team{TeamName: "alpha", Members: []*pb.TeamMember{
+				{UserId: "u1", Username: "alice", IsActive: true},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock_team.NewMockIUsecase(t)
+			tt.mockSetup(m)
+
+			got, err := NewServer(m).AddTeam(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantTeam != nil {
+				assert.Equal(t, tt.wantTeam.GetTeamName(), got.GetTeamName())
+				require.Len(t, got.GetMembers(), len(tt.wantTeam.GetMembers()))
+				assert.Equal(t, tt.wantTeam.GetMembers()[0].GetUserId(), got.GetMembers()[0].GetUserId())
+			}
+		})
+	}
+}
+
+func TestServer_SetReviewCapacity(t *testing.T) {
+	m := mock_team.NewMockIUsecase(t)
+	limit := 3
+	m.EXPECT().
+		SetReviewCapacity(mock.Anything, &entity.TeamReviewCapacity{TeamName: "alpha", MaxOpenReviews: &limit}).
+		Return(&entity.TeamReviewCapacity{TeamName: "alpha", MaxOpenReviews: &limit}, nil)
+
+	max := int32(3)
+	got, err := NewServer(m).SetReviewCapacity(context.Background(), &pb.TeamReviewCapacity{TeamName: "alpha", MaxOpenReviews: &max})
+
+	require.NoError(t, err)
+	assert.Equal(t, "alpha", got.GetTeamName())
+	require.NotNil(t, got.MaxOpenReviews)
+	assert.Equal(t, int32(3), got.GetMaxOpenReviews())
+}
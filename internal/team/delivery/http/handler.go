@@ -1,10 +1,10 @@
 package http
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
 	"github.com/Mockird31/avito_tech/internal/team"
 	json "github.com/Mockird31/avito_tech/pkg/json"
 	"github.com/asaskevich/govalidator"
@@ -42,15 +42,7 @@ func (h *Handler) AddTeam(w http.ResponseWriter, r *http.Request) {
 
 	resultTeam, err := h.usecase.AddTeam(ctx, &addTeamRequest)
 	if err != nil {
-		var statusCode int
-		switch {
-		case errors.Is(err, entity.ErrTeamNameExist):
-			statusCode = http.StatusNotFound
-		default:
-			statusCode = http.StatusInternalServerError
-		}
-
-		json.WriteErrorJson(w, statusCode, err.Error())
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
 		return
 	}
 
@@ -68,16 +60,100 @@ func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request) {
 
 	resultTeam, err := h.usecase.GetTeam(ctx, teamName)
 	if err != nil {
-		var statusCode int
-		switch {
-		case errors.Is(err, entity.ErrTeamNameNotFound):
-			statusCode = http.StatusNotFound
-		default:
-			statusCode = http.StatusInternalServerError
-		}
-		json.WriteErrorJson(w, statusCode, err.Error())
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
 		return
 	}
 
 	json.WriteJSON(w, http.StatusOK, resultTeam, nil)
 }
+
+func (h *Handler) SetReviewCapacity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var capacityRequest entity.TeamReviewCapacity
+	// тело не разбирается, если max_open_reviews не число, поэтому ошибка разбора - некорректный лимит
+	err := json.ReadJSON(w, r, &capacityRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, entity.ErrInvalidReviewCapacity.Error())
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(capacityRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	capacity, err := h.usecase.SetReviewCapacity(ctx, &capacityRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.TeamReviewCapacityResponse{Capacity: capacity}, nil)
+}
+
+func (h *Handler) SetReviewSla(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var slaRequest entity.TeamReviewSla
+	err := json.ReadJSON(w, r, &slaRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to parse request")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(slaRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	sla, err := h.usecase.SetReviewSla(ctx, &slaRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.TeamReviewSlaResponse{Sla: sla}, nil)
+}
+
+func (h *Handler) SetReviewerLimits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var limitsRequest entity.TeamReviewerLimits
+	err := json.ReadJSON(w, r, &limitsRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to parse request")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(limitsRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	limits, err := h.usecase.SetReviewerLimits(ctx, &limitsRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.TeamReviewerLimitsResponse{Limits: limits}, nil)
+}
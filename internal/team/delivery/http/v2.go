@@ -0,0 +1,122 @@
+package http
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	json "github.com/Mockird31/avito_tech/pkg/json"
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+)
+
+// Обработчики /api/v2: команда адресуется именем в пути, ошибки разбора тела - 400, коды ошибок без исключений v1.
+
+func (h *Handler) CreateTeamV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var addTeamRequest entity.Team
+	if err := json.ReadJSON(w, r, &addTeamRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if _, err := govalidator.ValidateStruct(addTeamRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resultTeam, err := h.usecase.AddTeam(ctx, &addTeamRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	headers := http.Header{"Location": {"/api/v2/teams/" + url.PathEscape(resultTeam.TeamName)}}
+	json.WriteJSON(w, http.StatusCreated, &entity.TeamResponse{Team: resultTeam}, headers)
+}
+
+func (h *Handler) GetTeamV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resultTeam, err := h.usecase.GetTeam(ctx, mux.Vars(r)["name"])
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.TeamResponse{Team: resultTeam}, nil)
+}
+
+func (h *Handler) SetReviewCapacityV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var capacityRequest entity.TeamReviewCapacity
+	if err := json.ReadJSON(w, r, &capacityRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, entity.ErrInvalidReviewCapacity.Error())
+		return
+	}
+	capacityRequest.TeamName = mux.Vars(r)["name"]
+
+	if _, err := govalidator.ValidateStruct(capacityRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	capacity, err := h.usecase.SetReviewCapacity(ctx, &capacityRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.TeamReviewCapacityResponse{Capacity: capacity}, nil)
+}
+
+func (h *Handler) SetReviewSlaV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var slaRequest entity.TeamReviewSla
+	if err := json.ReadJSON(w, r, &slaRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+	slaRequest.TeamName = mux.Vars(r)["name"]
+
+	if _, err := govalidator.ValidateStruct(slaRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sla, err := h.usecase.SetReviewSla(ctx, &slaRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.TeamReviewSlaResponse{Sla: sla}, nil)
+}
+
+func (h *Handler) SetReviewerLimitsV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var limitsRequest entity.TeamReviewerLimits
+	if err := json.ReadJSON(w, r, &limitsRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+	limitsRequest.TeamName = mux.Vars(r)["name"]
+
+	if _, err := govalidator.ValidateStruct(limitsRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limits, err := h.usecase.SetReviewerLimits(ctx, &limitsRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.TeamReviewerLimitsResponse{Limits: limits}, nil)
+}
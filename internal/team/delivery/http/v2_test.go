@@ -0,0 +1,94 @@
+package http
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	mock_team "github.com/Mockird31/avito_tech/mocks/team"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_CreateTeamV2(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(m *mock_team.MockIUsecase)
+		wantStatusCode int
+		wantLocation   string
+		wantBody       string
+	}{
+		{
+			name:           "invalid_json_body",
+			body:           `{"team_name": "alpha", "members": [`,
+			mockSetup:      func(m *mock_team.MockIUsecase) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"error":{"code":400,"message":"failed to parse request"}}`,
+		},
+		{
+			name: "team_exists",
+			body: `{"team_name": "alpha", "members": []}`,
+			mockSetup: func(m *mock_team.MockIUsecase) {
+				m.EXPECT().
+					AddTeam(mock.Anything, mock.AnythingOfType("*entity.Team")).
+					Return(nil, entity.ErrTeamNameExist)
+			},
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"error":{"code":409,"message":"team_name already exists"}}`,
+		},
+		{
+			name: "success",
+			body: `{"team_name": "alpha team", "members": [{"user_id":"u1","username":"alice","is_active":true}]}`,
+			mockSetup: func(m *mock_team.MockIUsecase) {
+				m.EXPECT().
+					AddTeam(mock.Anything, mock.AnythingOfType("*entity.Team")).
+					Return(&entity.Team{TeamName: "alpha team", Members: []*entity.TeamMember{
+						{UserID: "u1", Username: "alice", IsActive: true},
+					}}, nil)
+			},
+			wantStatusCode: http.StatusCreated,
+			wantLocation:   "/api/v2/teams/alpha%20team",
+			wantBody:       `{"team":{"team_name":"alpha team","members":[{"user_id":"u1","username":"alice","is_active":true}]}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock_team.NewMockIUsecase(t)
+			tt.mockSetup(m)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v2/teams", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			http.HandlerFunc(NewHandler(m).CreateTeamV2).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatusCode, rr.Code)
+			assert.Equal(t, tt.wantLocation, rr.Header().Get("Location"))
+			assert.JSONEq(t, tt.wantBody, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_SetReviewCapacityV2(t *testing.T) {
+	m := mock_team.NewMockIUsecase(t)
+	limit := 2
+	m.EXPECT().
+		SetReviewCapacity(mock.Anything, &entity.TeamReviewCapacity{TeamName: "alpha", MaxOpenReviews: &limit}).
+		Return(&entity.TeamReviewCapacity{TeamName: "alpha", MaxOpenReviews: &limit}, nil)
+
+	// имя команды берется из пути, а не из тела
+	req := httptest.NewRequest(http.MethodPut, "/api/v2/teams/alpha/review-capacity", bytes.NewBufferString(`{"team_name": "beta", "max_open_reviews": 2}`))
+	req = mux.SetURLVars(req, map[string]string{"name": "alpha"})
+	rr := httptest.NewRecorder()
+
+	http.HandlerFunc(NewHandler(m).SetReviewCapacityV2).ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"capacity":{"team_name":"alpha","max_open_reviews":2}}`, rr.Body.String())
+}
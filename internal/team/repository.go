@@ -2,9 +2,15 @@ package team
 
 import (
 	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
 )
 
 type IRepository interface {
 	CheckTeamNameExist(ctx context.Context, teamName string) (bool, error)
 	CreateTeam(ctx context.Context, teamName string) error
+	SetReviewCapacity(ctx context.Context, teamName string, maxOpenReviews *int) error
+	SetReviewSla(ctx context.Context, teamName string, reviewSlaHours *int, autoReassign bool) error
+	SetReviewerLimits(ctx context.Context, teamName string, minReviewers int, maxReviewers int) error
+	ListTeams(ctx context.Context) ([]*entity.TeamSummary, error)
 }
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/Mockird31/avito_tech/internal/entity"
 	"github.com/Mockird31/avito_tech/internal/team"
 	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
 	"go.uber.org/zap"
@@ -20,6 +21,39 @@ const (
 	CreateTeamQuery = `
 		INSERT INTO team (name) VALUES ($1)
 	`
+
+	SetReviewCapacityQuery = `
+		UPDATE team
+		SET max_open_reviews = $1, updated_at = NOW()
+		WHERE name = $2;
+	`
+
+	SetReviewSlaQuery = `
+		UPDATE team
+		SET review_sla_hours = $1, review_sla_auto_reassign = $2, updated_at = NOW()
+		WHERE name = $3;
+	`
+
+	SetReviewerLimitsQuery = `
+		UPDATE team
+		SET min_reviewers = $1, max_reviewers = $2, updated_at = NOW()
+		WHERE name = $3;
+	`
+
+	ListTeamsQuery = `
+		SELECT t.name,
+			COUNT(u.id),
+			COUNT(u.id) FILTER (WHERE u.is_active),
+			t.max_open_reviews,
+			t.review_sla_hours,
+			t.review_sla_auto_reassign,
+			t.min_reviewers,
+			t.max_reviewers
+		FROM team t
+		LEFT JOIN "user" u ON u.team_name = t.name AND u.deleted_at IS NULL
+		GROUP BY t.name
+		ORDER BY t.name;
+	`
 )
 
 type repository struct {
@@ -56,3 +90,65 @@ func (r *repository) CreateTeam(ctx context.Context, teamName string) error {
 	}
 	return nil
 }
+
+func (r *repository) SetReviewCapacity(ctx context.Context, teamName string, maxOpenReviews *int) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	if _, err := r.db.ExecContext(ctx, SetReviewCapacityQuery, maxOpenReviews, teamName); err != nil {
+		logger.Error("failed to set team review capacity:", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) SetReviewSla(ctx context.Context, teamName string, reviewSlaHours *int, autoReassign bool) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	if _, err := r.db.ExecContext(ctx, SetReviewSlaQuery, reviewSlaHours, autoReassign, teamName); err != nil {
+		logger.Error("failed to set team review sla:", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) SetReviewerLimits(ctx context.Context, teamName string, minReviewers int, maxReviewers int) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	if _, err := r.db.ExecContext(ctx, SetReviewerLimitsQuery, minReviewers, maxReviewers, teamName); err != nil {
+		logger.Error("failed to set team reviewer limits:", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) ListTeams(ctx context.Context) (teams []*entity.TeamSummary, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, ListTeamsQuery)
+	if err != nil {
+		logger.Error("failed to list teams", zap.Error(err))
+		return nil, err
+	}
+
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	teams = make([]*entity.TeamSummary, 0)
+	for rows.Next() {
+		var summary entity.TeamSummary
+		err := rows.Scan(&summary.TeamName, &summary.Members, &summary.ActiveMembers, &summary.MaxOpenReviews, &summary.ReviewSlaHours, &summary.AutoReassign, &summary.MinReviewers, &summary.MaxReviewers)
+		if err != nil {
+			logger.Error("failed to scan team", zap.Error(err))
+			return nil, err
+		}
+		teams = append(teams, &summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to pass through rows", zap.Error(err))
+		return nil, err
+	}
+	return teams, nil
+}
@@ -111,3 +111,127 @@ func TestCreateTeam_Failure(t *testing.T) {
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestSetReviewCapacity_Successfull(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	teamName := "team2"
+	maxOpenReviews := 3
+
+	mock.ExpectExec(regexp.QuoteMeta(SetReviewCapacityQuery)).WithArgs(maxOpenReviews, teamName).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetReviewCapacity(ctx, teamName, &maxOpenReviews)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetReviewCapacity_Reset(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	teamName := "team2"
+
+	mock.ExpectExec(regexp.QuoteMeta(SetReviewCapacityQuery)).WithArgs(nil, teamName).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetReviewCapacity(ctx, teamName, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetReviewCapacity_Failure(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	teamName := "team2"
+	maxOpenReviews := 3
+	dbErr := errors.New("update failed")
+
+	mock.ExpectExec(regexp.QuoteMeta(SetReviewCapacityQuery)).WithArgs(maxOpenReviews, teamName).WillReturnError(dbErr)
+
+	err := repo.SetReviewCapacity(ctx, teamName, &maxOpenReviews)
+	require.Error(t, err)
+	assert.EqualError(t, err, dbErr.Error())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetReviewSla_Successfull(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	teamName := "team2"
+	hours := 48
+
+	mock.ExpectExec(regexp.QuoteMeta(SetReviewSlaQuery)).WithArgs(hours, true, teamName).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetReviewSla(ctx, teamName, &hours, true)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetReviewerLimits_Successfull(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(SetReviewerLimitsQuery)).WithArgs(2, 4, "team2").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetReviewerLimits(ctx, "team2", 2, 4)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTeams_Successfull(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"name", "members", "active_members", "max_open_reviews", "review_sla_hours", "review_sla_auto_reassign", "min_reviewers", "max_reviewers"}).
+		AddRow("backend", 3, 2, 5, nil, false, 1, 5).
+		AddRow("frontend", 0, 0, nil, 24, true, 0, 2)
+
+	mock.ExpectQuery(regexp.QuoteMeta(ListTeamsQuery)).WillReturnRows(rows)
+
+	teams, err := repo.ListTeams(ctx)
+	require.NoError(t, err)
+	require.Len(t, teams, 2)
+
+	assert.Equal(t, "backend", teams[0].TeamName)
+	assert.Equal(t, 3, teams[0].Members)
+	assert.Equal(t, 2, teams[0].ActiveMembers)
+	require.NotNil(t, teams[0].MaxOpenReviews)
+	assert.Equal(t, 5, *teams[0].MaxOpenReviews)
+	assert.Nil(t, teams[0].ReviewSlaHours)
+	assert.Equal(t, 1, teams[0].MinReviewers)
+	assert.Equal(t, 5, teams[0].MaxReviewers)
+
+	assert.Nil(t, teams[1].MaxOpenReviews)
+	require.NotNil(t, teams[1].ReviewSlaHours)
+	assert.Equal(t, 24, *teams[1].ReviewSlaHours)
+	assert.True(t, teams[1].AutoReassign)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTeams_Failure(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(ListTeamsQuery)).WillReturnError(errors.New("db error"))
+
+	teams, err := repo.ListTeams(ctx)
+	require.Error(t, err)
+	assert.Nil(t, teams)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -9,4 +9,8 @@ import (
 type IUsecase interface {
 	AddTeam(ctx context.Context, team *entity.Team) (*entity.Team, error)
 	GetTeam(ctx context.Context, teamName string) (*entity.Team, error)
+	SetReviewCapacity(ctx context.Context, capacity *entity.TeamReviewCapacity) (*entity.TeamReviewCapacity, error)
+	SetReviewSla(ctx context.Context, sla *entity.TeamReviewSla) (*entity.TeamReviewSla, error)
+	SetReviewerLimits(ctx context.Context, limits *entity.TeamReviewerLimits) (*entity.TeamReviewerLimits, error)
+	ListTeams(ctx context.Context) ([]*entity.TeamSummary, error)
 }
@@ -30,14 +30,23 @@ func (u *usecase) AddTeam(ctx context.Context, team *entity.Team) (*entity.Team,
 		return nil, entity.ErrTeamNameExist
 	}
 
-	err = u.TeamRepository.CreateTeam(ctx, team.TeamName)
+	membersIds := make([]string, 0, len(team.Members))
+	for _, member := range team.Members {
+		membersIds = append(membersIds, member.UserID)
+	}
+
+	// удаленный пользователь остается в таблице, поэтому его нельзя ни создать заново, ни перенести
+	deletedIds, err := u.UserRepository.GetDeletedUserIds(ctx, membersIds)
 	if err != nil {
 		return nil, err
 	}
+	if len(deletedIds) > 0 {
+		return nil, entity.ErrUserDeleted
+	}
 
-	membersIds := make([]string, 0, len(team.Members))
-	for _, member := range team.Members {
-		membersIds = append(membersIds, member.UserID)
+	err = u.TeamRepository.CreateTeam(ctx, team.TeamName)
+	if err != nil {
+		return nil, err
 	}
 
 	existentIds, err := u.UserRepository.GetExistentUsers(ctx, membersIds)
@@ -94,3 +103,73 @@ func (u *usecase) GetTeam(ctx context.Context, teamName string) (*entity.Team, e
 	}
 	return collectedTeam, nil
 }
+
+func (u *usecase) SetReviewCapacity(ctx context.Context, capacity *entity.TeamReviewCapacity) (*entity.TeamReviewCapacity, error) {
+	if capacity.MaxOpenReviews != nil && *capacity.MaxOpenReviews < 1 {
+		return nil, entity.ErrInvalidReviewCapacity
+	}
+
+	isExist, err := u.TeamRepository.CheckTeamNameExist(ctx, capacity.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		return nil, entity.ErrTeamNameNotFound
+	}
+
+	err = u.TeamRepository.SetReviewCapacity(ctx, capacity.TeamName, capacity.MaxOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+
+	return capacity, nil
+}
+
+func (u *usecase) SetReviewSla(ctx context.Context, sla *entity.TeamReviewSla) (*entity.TeamReviewSla, error) {
+	if sla.ReviewSlaHours != nil && *sla.ReviewSlaHours < 1 {
+		return nil, entity.ErrInvalidReviewSla
+	}
+
+	isExist, err := u.TeamRepository.CheckTeamNameExist(ctx, sla.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		return nil, entity.ErrTeamNameNotFound
+	}
+
+	err = u.TeamRepository.SetReviewSla(ctx, sla.TeamName, sla.ReviewSlaHours, sla.AutoReassign)
+	if err != nil {
+		return nil, err
+	}
+
+	return sla, nil
+}
+
+func (u *usecase) ListTeams(ctx context.Context) ([]*entity.TeamSummary, error) {
+	return u.TeamRepository.ListTeams(ctx)
+}
+
+func (u *usecase) SetReviewerLimits(ctx context.Context, limits *entity.TeamReviewerLimits) (*entity.TeamReviewerLimits, error) {
+	if limits.MaxReviewers < 1 || limits.MinReviewers < 0 || limits.MinReviewers > limits.MaxReviewers {
+		return nil, entity.ErrInvalidReviewerLimits
+	}
+
+	isExist, err := u.TeamRepository.CheckTeamNameExist(ctx, limits.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		return nil, entity.ErrTeamNameNotFound
+	}
+
+	err = u.TeamRepository.SetReviewerLimits(ctx, limits.TeamName, limits.MinReviewers, limits.MaxReviewers)
+	if err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
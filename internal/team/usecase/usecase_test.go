@@ -71,6 +71,9 @@ func TestAddTeam_Success_MixedExistent(t *testing.T) {
 	teamRepo.EXPECT().
 		CheckTeamNameExist(mock.Anything, teamName).
 		Return(false, nil)
+	userRepo.EXPECT().
+		GetDeletedUserIds(mock.Anything, []string{"u1", "u2"}).
+		Return([]string{}, nil)
 	teamRepo.EXPECT().
 		CreateTeam(mock.Anything, teamName).
 		Return(nil)
@@ -89,3 +92,144 @@ func TestAddTeam_Success_MixedExistent(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, req, res)
 }
+
+func TestAddTeam_DeletedMember(t *testing.T) {
+	ctx := getTestContext()
+
+	teamRepo := mock_team.NewMockIRepository(t)
+	userRepo := mock_user.NewMockIRepository(t)
+	uc := NewUsecase(teamRepo, userRepo)
+
+	req := &entity.Team{
+		TeamName: "delta",
+		Members:  []*entity.TeamMember{{UserID: "u1", Username: "alice", IsActive: true}},
+	}
+
+	teamRepo.EXPECT().
+		CheckTeamNameExist(mock.Anything, "delta").
+		Return(false, nil)
+	userRepo.EXPECT().
+		GetDeletedUserIds(mock.Anything, []string{"u1"}).
+		Return([]string{"u1"}, nil)
+
+	res, err := uc.AddTeam(ctx, req)
+	assert.ErrorIs(t, err, entity.ErrUserDeleted)
+	assert.Nil(t, res)
+}
+
+func TestSetReviewCapacity_InvalidValue(t *testing.T) {
+	ctx := getTestContext()
+	uc, teamRepo, _ := setupTest(t)
+
+	zero := 0
+	req := &entity.TeamReviewCapacity{TeamName: "alpha", MaxOpenReviews: &zero}
+
+	res, err := uc.SetReviewCapacity(ctx, req)
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrInvalidReviewCapacity)
+
+	teamRepo.AssertNotCalled(t, "SetReviewCapacity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetReviewCapacity_TeamNotFound(t *testing.T) {
+	ctx := getTestContext()
+	uc, teamRepo, _ := setupTest(t)
+
+	limit := 2
+	req := &entity.TeamReviewCapacity{TeamName: "alpha", MaxOpenReviews: &limit}
+
+	teamRepo.EXPECT().
+		CheckTeamNameExist(mock.Anything, "alpha").
+		Return(false, nil)
+
+	res, err := uc.SetReviewCapacity(ctx, req)
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrTeamNameNotFound)
+}
+
+func TestSetReviewCapacity_Success(t *testing.T) {
+	ctx := getTestContext()
+	uc, teamRepo, _ := setupTest(t)
+
+	limit := 2
+	req := &entity.TeamReviewCapacity{TeamName: "alpha", MaxOpenReviews: &limit}
+
+	teamRepo.EXPECT().
+		CheckTeamNameExist(mock.Anything, "alpha").
+		Return(true, nil)
+	teamRepo.EXPECT().
+		SetReviewCapacity(mock.Anything, "alpha", &limit).
+		Return(nil)
+
+	res, err := uc.SetReviewCapacity(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, req, res)
+}
+
+func TestSetReviewSla_InvalidValue(t *testing.T) {
+	ctx := getTestContext()
+	uc, teamRepo, _ := setupTest(t)
+
+	zero := 0
+	req := &entity.TeamReviewSla{TeamName: "alpha", ReviewSlaHours: &zero}
+
+	res, err := uc.SetReviewSla(ctx, req)
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrInvalidReviewSla)
+
+	teamRepo.AssertNotCalled(t, "SetReviewSla", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetReviewSla_Success(t *testing.T) {
+	ctx := getTestContext()
+	uc, teamRepo, _ := setupTest(t)
+
+	hours := 24
+	req := &entity.TeamReviewSla{TeamName: "alpha", ReviewSlaHours: &hours, AutoReassign: true}
+
+	teamRepo.EXPECT().
+		CheckTeamNameExist(mock.Anything, "alpha").
+		Return(true, nil)
+	teamRepo.EXPECT().
+		SetReviewSla(mock.Anything, "alpha", &hours, true).
+		Return(nil)
+
+	res, err := uc.SetReviewSla(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, req, res)
+}
+
+func TestSetReviewerLimits_InvalidValue(t *testing.T) {
+	ctx := getTestContext()
+	uc, teamRepo, _ := setupTest(t)
+
+	req := &entity.TeamReviewerLimits{TeamName: "alpha", MinReviewers: 3, MaxReviewers: 2}
+
+	res, err := uc.SetReviewerLimits(ctx, req)
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrInvalidReviewerLimits)
+
+	teamRepo.AssertNotCalled(t, "SetReviewerLimits", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetReviewerLimits_Success(t *testing.T) {
+	ctx := getTestContext()
+	uc, teamRepo, _ := setupTest(t)
+
+	req := &entity.TeamReviewerLimits{TeamName: "alpha", MinReviewers: 2, MaxReviewers: 3}
+
+	teamRepo.EXPECT().
+		CheckTeamNameExist(mock.Anything, "alpha").
+		Return(true, nil)
+	teamRepo.EXPECT().
+		SetReviewerLimits(mock.Anything, "alpha", 2, 3).
+		Return(nil)
+
+	res, err := uc.SetReviewerLimits(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, req, res)
+}
@@ -0,0 +1,288 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/grpc/convert"
+	"github.com/Mockird31/avito_tech/internal/grpc/pb"
+	"github.com/Mockird31/avito_tech/internal/user"
+	"github.com/asaskevich/govalidator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Server struct {
+	pb.UnimplementedUserServiceServer
+	usecase user.IUsecase
+}
+
+func NewServer(usecase user.IUsecase) *Server {
+	return &Server{
+		usecase: usecase,
+	}
+}
+
+func (s *Server) SetIsActive(ctx context.Context, req *pb.SetIsActiveRequest) (*pb.User, error) {
+	userUpdateActive := &entity.UserUpdateActive{
+		UserId:   req.GetUserId(),
+		IsActive: req.GetIsActive(),
+	}
+
+	if _, err := govalidator.ValidateStruct(userUpdateActive); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	u, err := s.usecase.SetIsActive(ctx, userUpdateActive)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return userToProto(u), nil
+}
+
+func (s *Server) GetUserReview(ctx context.Context, req *pb.UserPullRequestsRequest) (*pb.ReviewerPullRequests, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	filter, err := convert.PullRequestFilter(req.GetFilter())
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+	filter.ReviewerId = req.GetUserId()
+
+	reviewerPullRequests, err := s.usecase.GetUserReview(ctx, filter)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.ReviewerPullRequests{
+		UserId:       reviewerPullRequests.UserId,
+		PullRequests: convert.PullRequestShorts(reviewerPullRequests.PullRequests),
+		Total:        int32(reviewerPullRequests.Total),
+		NextCursor:   reviewerPullRequests.NextCursor,
+	}, nil
+}
+
+func (s *Server) GetUserAuthored(ctx context.Context, req *pb.UserPullRequestsRequest) (*pb.AuthorPullRequests, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	filter, err := convert.PullRequestFilter(req.GetFilter())
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+	filter.AuthorId = req.GetUserId()
+
+	authorPullRequests, err := s.usecase.GetUserAuthored(ctx, filter)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	pullRequests := make([]*pb.AuthoredPullRequest, 0, len(authorPullRequests.PullRequests))
+	for _, pr := range authorPullRequests.PullRequests {
+		reviewers := make([]*pb.PullRequestReviewer, 0, len(pr.Reviewers))
+		for _, reviewer := range pr.Reviewers {
+			reviewers = append(reviewers, &pb.PullRequestReviewer{
+				UserId:     reviewer.UserId,
+				Username:   reviewer.Username,
+				IsActive:   reviewer.IsActive,
+				AssignedAt: timestamppb.New(reviewer.AssignedAt),
+				State:      reviewer.State,
+			})
+		}
+		pullRequests = append(pullRequests, &pb.AuthoredPullRequest{
+			PullRequest: convert.PullRequestShort(pr.PullRequestShort),
+			Reviewers:   reviewers,
+		})
+	}
+
+	return &pb.AuthorPullRequests{
+		UserId:       authorPullRequests.UserId,
+		PullRequests: pullRequests,
+		Total:        int32(authorPullRequests.Total),
+		NextCursor:   authorPullRequests.NextCursor,
+	}, nil
+}
+
+func (s *Server) DeactivateTeamUsers(ctx context.Context, req *pb.DeactivateUsersRequest) (*pb.DeactivateUsersResult, error) {
+	deactivateUsers := &entity.DeactivateUsers{
+		TeamName: req.GetTeamName(),
+		UserIds:  req.GetUserIds(),
+		DryRun:   req.GetDryRun(),
+	}
+
+	if _, err := govalidator.ValidateStruct(deactivateUsers); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.usecase.DeactivateTeamUsers(ctx, deactivateUsers)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.DeactivateUsersResult{
+		TeamName:      result.TeamName,
+		UserIds:       result.UserIds,
+		DryRun:        result.DryRun,
+		Reassignments: convert.ReviewerReassignments(result.Reassignments),
+		Summary:       convert.DeactivationSummary(result.Summary),
+	}, nil
+}
+
+func (s *Server) ReactivateTeamUsers(ctx context.Context, req *pb.ReactivateUsersRequest) (*pb.ReactivateUsersResult, error) {
+	reactivateUsers := &entity.ReactivateUsers{
+		TeamName:  req.GetTeamName(),
+		UserIds:   req.GetUserIds(),
+		Rebalance: req.GetRebalance(),
+		DryRun:    req.GetDryRun(),
+	}
+
+	if _, err := govalidator.ValidateStruct(reactivateUsers); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.usecase.ReactivateTeamUsers(ctx, reactivateUsers)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.ReactivateUsersResult{
+		TeamName: result.TeamName,
+		UserIds:  result.UserIds,
+		DryRun:   result.DryRun,
+		Moves:    convert.ReviewerMoves(result.Moves),
+	}, nil
+}
+
+func (s *Server) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	userCreate := &entity.UserCreate{
+		UserId:   req.GetUserId(),
+		Username: req.GetUsername(),
+		TeamName: req.GetTeamName(),
+		IsActive: req.GetIsActive(),
+	}
+
+	if _, err := govalidator.ValidateStruct(userCreate); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	u, err := s.usecase.CreateUser(ctx, userCreate)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return userToProto(u), nil
+}
+
+func (s *Server) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	userUpdate := &entity.UserUpdate{
+		UserId:   req.GetUserId(),
+		Username: req.Username,
+		TeamName: req.TeamName,
+	}
+
+	if _, err := govalidator.ValidateStruct(userUpdate); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	u, err := s.usecase.UpdateUser(ctx, userUpdate)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return userToProto(u), nil
+}
+
+func (s *Server) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserResult, error) {
+	userDelete := &entity.UserDelete{UserId: req.GetUserId()}
+
+	if _, err := govalidator.ValidateStruct(userDelete); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.usecase.DeleteUser(ctx, userDelete)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.DeleteUserResult{
+		UserId:        result.UserId,
+		Reassignments: convert.ReviewerReassignments(result.Reassignments),
+		Summary:       convert.DeactivationSummary(result.Summary),
+	}, nil
+}
+
+func (s *Server) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	if req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	u, err := s.usecase.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return userToProto(u), nil
+}
+
+func (s *Server) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.UserList, error) {
+	filter := &entity.UserListFilter{
+		TeamName: req.GetTeamName(),
+		IsActive: req.IsActive,
+		Search:   req.GetSearch(),
+		Limit:    int(req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	}
+
+	userList, err := s.usecase.ListUsers(ctx, filter)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	users := make([]*pb.User, 0, len(userList.Users))
+	for _, u := range userList.Users {
+		users = append(users, userToProto(u))
+	}
+
+	return &pb.UserList{
+		Users:  users,
+		Total:  int32(userList.Total),
+		Limit:  int32(userList.Limit),
+		Offset: int32(userList.Offset),
+	}, nil
+}
+
+func (s *Server) SetReviewCapacity(ctx context.Context, req *pb.UserReviewCapacity) (*pb.UserReviewCapacity, error) {
+	capacity := &entity.UserReviewCapacity{
+		UserId:         req.GetUserId(),
+		MaxOpenReviews: convert.IntPtr(req.MaxOpenReviews),
+	}
+
+	if _, err := govalidator.ValidateStruct(capacity); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := s.usecase.SetReviewCapacity(ctx, capacity)
+	if err != nil {
+		return nil, errmap.GRPCError(err)
+	}
+
+	return &pb.UserReviewCapacity{
+		UserId:         result.UserId,
+		MaxOpenReviews: convert.Int32Ptr(result.MaxOpenReviews),
+	}, nil
+}
+
+func userToProto(u *entity.User) *pb.User {
+	return &pb.User{
+		UserId:   u.UserId,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+}
@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/grpc/pb"
+	mock_user "github.com/Mockird31/avito_tech/mocks/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_GetUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *pb.GetUserRequest
+		mockSetup func(m *mock_user.MockIUsecase)
+		wantCode  codes.Code
+		wantUser  *pb.User
+	}{
+		{
+			name:      "missing_user_id",
+			req:       &pb.GetUserRequest{},
+			mockSetup: func(m *mock_user.MockIUsecase) {},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name: "not_found",
+			req:  &pb.GetUserRequest{UserId: "u1"},
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				m.EXPECT().GetUser(mock.Anything, "u1").Return(nil, entity.ErrUserNotFound)
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "success",
+			req:  &pb.GetUserRequest{UserId: "u1"},
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				m.EXPECT().GetUser(mock.Anything, "u1").
+					Return(&entity.User{UserId: "u1", Username: "alice", TeamName: "backend", IsActive: true}, nil)
+			},
+			wantCode: codes.OK,
+			wantUser: &pb.User{UserId: "u1", Username: "alice", TeamName: "backend", IsActive: true},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock_user.NewMockIUsecase(t)
+			tt.mockSetup(m)
+
+			got, err := NewServer(m).GetUser(context.Background(), tt.req)
+
+			require.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantUser != nil {
+				assert.Equal(t, tt.wantUser.GetUserId(), got.GetUserId())
+				assert.Equal(t, tt.wantUser.GetUsername(), got.GetUsername())
+				assert.Equal(t, tt.wantUser.GetTeamName(), got.GetTeamName())
+				assert.Equal(t, tt.wantUser.GetIsActive(), got.GetIsActive())
+			}
+		})
+	}
+}
+
+func TestServer_ListUsers(t *testing.T) {
+	m := mock_user.NewMockIUsecase(t)
+	isActive := true
+	m.EXPECT().
+		ListUsers(mock.Anything, &entity.UserListFilter{TeamName: "backend", IsActive: &isActive, Limit: 10}).
+		Return(&entity.UserList{
+			Users: []*entity.User{{UserId: "u1", TeamName: "backend", IsActive: true}},
+			Total: 1,
+			Limit: 10,
+		}, nil)
+
+	got, err := NewServer(m).ListUsers(context.Background(), &pb.ListUsersRequest{TeamName: "backend", IsActive: &isActive, Limit: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), got.GetTotal())
+	require.Len(t, got.GetUsers(), 1)
+	assert.Equal(t, "u1", got.GetUsers()[0].GetUserId())
+}
@@ -1,14 +1,16 @@
 package http
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/httpquery"
 	"github.com/Mockird31/avito_tech/internal/user"
 	"github.com/asaskevich/govalidator"
 
 	json "github.com/Mockird31/avito_tech/pkg/json"
+	"github.com/Mockird31/avito_tech/pkg/query"
 )
 
 type Handler struct {
@@ -44,14 +46,7 @@ func (h *Handler) SetUserIsActive(w http.ResponseWriter, r *http.Request) {
 
 	user, err := h.usecase.SetIsActive(ctx, &userActiveRequest)
 	if err != nil {
-		var statusCode int
-		switch {
-		case errors.Is(err, entity.ErrUserNotFound):
-			statusCode = http.StatusNotFound
-		default:
-			statusCode = http.StatusInternalServerError
-		}
-		json.WriteErrorJson(w, statusCode, err.Error())
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
 		return
 	}
 
@@ -67,21 +62,45 @@ func (h *Handler) GetUserReviews(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pullRequests, userId, err := h.usecase.GetUserReview(ctx, userId)
+	filter, err := httpquery.PullRequestFilter(r.URL.Query())
 	if err != nil {
-		var statusCode int
-		switch {
-		case errors.Is(err, entity.ErrUserNotFound):
-			statusCode = http.StatusNotFound
-		default:
-			statusCode = http.StatusInternalServerError
-		}
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.ReviewerId = userId
+
+	reviewerPullRequests, err := h.usecase.GetUserReview(ctx, filter)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, reviewerPullRequests, nil)
+}
+
+func (h *Handler) GetUserAuthored(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId := r.URL.Query().Get("user_id")
+	if userId == "" {
+		json.WriteErrorJson(w, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
 
-		json.WriteErrorJson(w, statusCode, err.Error())
+	filter, err := httpquery.PullRequestFilter(r.URL.Query())
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
 		return
 	}
+	filter.AuthorId = userId
 
-	json.WriteJSON(w, http.StatusOK, &entity.ReviewerPullRequests{UserId: userId, PullRequests: pullRequests}, nil)
+	authorPullRequests, err := h.usecase.GetUserAuthored(ctx, filter)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, authorPullRequests, nil)
 }
 
 func (h *Handler) DeactivateTeamUsers(w http.ResponseWriter, r *http.Request) {
@@ -108,18 +127,222 @@ func (h *Handler) DeactivateTeamUsers(w http.ResponseWriter, r *http.Request) {
 
 	deactivateUsersResp, err := h.usecase.DeactivateTeamUsers(ctx, &deactivateUsers)
 	if err != nil {
-		var status int
-		switch {
-		case errors.Is(err, entity.ErrUserNotFound):
-			status = http.StatusNotFound
-		case errors.Is(err, entity.ErrUsersNotSameTeam):
-			status = http.StatusBadRequest
-		default:
-			status = http.StatusInternalServerError
-		}
-		json.WriteErrorJson(w, status, err.Error())
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
 		return
 	}
 
 	json.WriteJSON(w, http.StatusOK, &entity.DeactivateUsersResponse{DeactivateUsers: deactivateUsersResp}, nil)
 }
+
+func (h *Handler) ReactivateTeamUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var reactivateUsers entity.ReactivateUsers
+
+	err := json.ReadJSON(w, r, &reactivateUsers)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to parse json")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(reactivateUsers)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	reactivateUsersResp, err := h.usecase.ReactivateTeamUsers(ctx, &reactivateUsers)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.ReactivateUsersResponse{ReactivateUsers: reactivateUsersResp}, nil)
+}
+
+func (h *Handler) SetReviewCapacity(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var capacityRequest entity.UserReviewCapacity
+
+	// тело не разбирается, если max_open_reviews не число, поэтому ошибка разбора - некорректный лимит
+	err := json.ReadJSON(w, r, &capacityRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, entity.ErrInvalidReviewCapacity.Error())
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(capacityRequest)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	capacity, err := h.usecase.SetReviewCapacity(ctx, &capacityRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserReviewCapacityResponse{Capacity: capacity}, nil)
+}
+
+func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var userCreate entity.UserCreate
+
+	err := json.ReadJSON(w, r, &userCreate)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to parse json")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(userCreate)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	user, err := h.usecase.CreateUser(ctx, &userCreate)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusCreated, &entity.UserResponse{User: user}, nil)
+}
+
+func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var userUpdate entity.UserUpdate
+
+	err := json.ReadJSON(w, r, &userUpdate)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to parse json")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(userUpdate)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	user, err := h.usecase.UpdateUser(ctx, &userUpdate)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserResponse{User: user}, nil)
+}
+
+func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var userDelete entity.UserDelete
+
+	err := json.ReadJSON(w, r, &userDelete)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, "failed to parse json")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(userDelete)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	deletedUser, err := h.usecase.DeleteUser(ctx, &userDelete)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserDeleteResponse{DeletedUser: deletedUser}, nil)
+}
+
+func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userId := r.URL.Query().Get("user_id")
+	if userId == "" {
+		json.WriteErrorJson(w, http.StatusNotFound, "NOT_FOUND")
+		return
+	}
+
+	user, err := h.usecase.GetUser(ctx, userId)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserResponse{User: user}, nil)
+}
+
+func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := parseUserListFilter(r)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	userList, err := h.usecase.ListUsers(ctx, filter)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserListResponse{UserList: userList}, nil)
+}
+
+func parseUserListFilter(r *http.Request) (*entity.UserListFilter, error) {
+	values := r.URL.Query()
+	filter := &entity.UserListFilter{
+		TeamName: values.Get("team_name"),
+		Search:   values.Get("search"),
+	}
+
+	var err error
+	if filter.IsActive, err = query.Bool(values, "is_active"); err != nil {
+		return nil, err
+	}
+	if filter.Limit, err = query.Int(values, "limit"); err != nil {
+		return nil, err
+	}
+	if filter.Offset, err = query.Int(values, "offset"); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
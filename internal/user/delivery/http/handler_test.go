@@ -138,8 +138,8 @@ func TestHandler_GetUserReviews(t *testing.T) {
 			query: "?user_id=missing",
 			mockSetup: func(m *mock_user.MockIUsecase) {
 				m.EXPECT().
-					GetUserReview(mock.Anything, "missing").
-					Return(nil, "", entity.ErrUserNotFound)
+					GetUserReview(mock.Anything, mock.MatchedBy(func(f *entity.PullRequestFilter) bool { return f.ReviewerId == "missing" })).
+					Return(nil, entity.ErrUserNotFound)
 			},
 			wantStatusCode: http.StatusNotFound,
 			wantBody:       `{"error":{"code":404,"message":"resource not found"}}`,
@@ -149,31 +149,63 @@ func TestHandler_GetUserReviews(t *testing.T) {
 			query: "?user_id=u1",
 			mockSetup: func(m *mock_user.MockIUsecase) {
 				m.EXPECT().
-					GetUserReview(mock.Anything, "u1").
-					Return(nil, "", errors.New("db failure"))
+					GetUserReview(mock.Anything, mock.AnythingOfType("*entity.PullRequestFilter")).
+					Return(nil, errors.New("db failure"))
 			},
 			wantStatusCode: http.StatusInternalServerError,
 			wantBody:       `{"error":{"code":500,"message":"db failure"}}`,
 		},
+		{
+			name:           "invalid_created_from",
+			query:          "?user_id=u1&created_from=yesterday",
+			mockSetup:      func(m *mock_user.MockIUsecase) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"error":{"code":400,"message":"invalid query parameter: created_from"}}`,
+		},
+		{
+			name:           "invalid_cursor",
+			query:          "?user_id=u1&cursor=not-a-cursor",
+			mockSetup:      func(m *mock_user.MockIUsecase) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"error":{"code":400,"message":"invalid pagination parameters"}}`,
+		},
+		{
+			name:  "usecase_invalid_filter",
+			query: "?user_id=u1&status=DRAFT",
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				m.EXPECT().
+					GetUserReview(mock.Anything, mock.AnythingOfType("*entity.PullRequestFilter")).
+					Return(nil, entity.ErrInvalidFilter)
+			},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"error":{"code":400,"message":"invalid filter parameters"}}`,
+		},
 		{
 			name:  "success",
-			query: "?user_id=u1",
+			query: "?user_id=u1&status=OPEN&order=asc&limit=2",
 			mockSetup: func(m *mock_user.MockIUsecase) {
-				prs := []*entity.PullRequestShort{
-					{Id: "pr1", PrName: "Fix bug", AuthorId: "a1", Status: "OPEN"},
-					{Id: "pr2", PrName: "Add feature", AuthorId: "a2", Status: "MERGED"},
+				res := &entity.ReviewerPullRequests{
+					UserId: "u1",
+					PullRequests: []*entity.PullRequestShort{
+						{Id: "pr1", PrName: "Fix bug", AuthorId: "a1", Status: "OPEN"},
+						{Id: "pr2", PrName: "Add feature", AuthorId: "a2", Status: "OPEN"},
+					},
+					Total:      3,
+					NextCursor: "next",
 				}
 				m.EXPECT().
-					GetUserReview(mock.Anything, "u1").
-					Return(prs, "u1", nil)
+					GetUserReview(mock.Anything, &entity.PullRequestFilter{ReviewerId: "u1", Status: "OPEN", Order: "asc", Limit: 2}).
+					Return(res, nil)
 			},
 			wantStatusCode: http.StatusOK,
 			wantResp: &entity.ReviewerPullRequests{
 				UserId: "u1",
 				PullRequests: []*entity.PullRequestShort{
 					{Id: "pr1", PrName: "Fix bug", AuthorId: "a1", Status: "OPEN"},
-					{Id: "pr2", PrName: "Add feature", AuthorId: "a2", Status: "MERGED"},
+					{Id: "pr2", PrName: "Add feature", AuthorId: "a2", Status: "OPEN"},
 				},
+				Total:      3,
+				NextCursor: "next",
 			},
 		},
 	}
@@ -206,3 +238,199 @@ func TestHandler_GetUserReviews(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_CreateUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(m *mock_user.MockIUsecase)
+		wantStatusCode int
+		wantUser       *entity.UserResponse
+		wantBody       string
+	}{
+		{
+			name:           "invalid_request",
+			body:           `{"user_id":"","username":"alice","team_name":"teamA"}`,
+			mockSetup:      func(m *mock_user.MockIUsecase) {},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "user_exists",
+			body: `{"user_id":"u1","username":"alice","team_name":"teamA","is_active":true}`,
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				m.EXPECT().
+					CreateUser(mock.Anything, mock.AnythingOfType("*entity.UserCreate")).
+					Return(nil, entity.ErrUserExist)
+			},
+			wantStatusCode: http.StatusConflict,
+			wantBody:       `{"error":{"code":409,"message":"user_id already exists"}}`,
+		},
+		{
+			name: "success",
+			body: `{"user_id":"u1","username":"alice","team_name":"teamA","is_active":true}`,
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				m.EXPECT().
+					CreateUser(mock.Anything, mock.AnythingOfType("*entity.UserCreate")).
+					Return(&entity.User{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}, nil)
+			},
+			wantStatusCode: http.StatusCreated,
+			wantUser:       &entity.UserResponse{User: &entity.User{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock_user.NewMockIUsecase(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(m)
+			}
+
+			h := NewHandler(m)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/create", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			http.HandlerFunc(h.CreateUser).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatusCode, rr.Code)
+
+			if tt.wantUser != nil {
+				var got entity.UserResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				assert.Equal(t, tt.wantUser, &got)
+			}
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_SetReviewCapacity(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(m *mock_user.MockIUsecase)
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "not_a_number",
+			body:           `{"user_id":"u1","max_open_reviews":"three"}`,
+			mockSetup:      func(m *mock_user.MockIUsecase) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"error":{"code":400,"message":"` + entity.ErrInvalidReviewCapacity.Error() + `"}}`,
+		},
+		{
+			name:           "fraction",
+			body:           `{"user_id":"u1","max_open_reviews":2.5}`,
+			mockSetup:      func(m *mock_user.MockIUsecase) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"error":{"code":400,"message":"` + entity.ErrInvalidReviewCapacity.Error() + `"}}`,
+		},
+		{
+			name: "success",
+			body: `{"user_id":"u1","max_open_reviews":3}`,
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				limit := 3
+				m.EXPECT().
+					SetReviewCapacity(mock.Anything, &entity.UserReviewCapacity{UserId: "u1", MaxOpenReviews: &limit}).
+					Return(&entity.UserReviewCapacity{UserId: "u1", MaxOpenReviews: &limit}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock_user.NewMockIUsecase(t)
+			tt.mockSetup(m)
+
+			req := httptest.NewRequest(http.MethodPost, "/users/setReviewCapacity", bytes.NewBufferString(tt.body))
+			rr := httptest.NewRecorder()
+
+			http.HandlerFunc(NewHandler(m).SetReviewCapacity).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatusCode, rr.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestHandler_ListUsers(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		mockSetup      func(m *mock_user.MockIUsecase)
+		wantStatusCode int
+		wantList       *entity.UserListResponse
+		wantBody       string
+	}{
+		{
+			name:           "invalid_is_active",
+			query:          "?is_active=maybe",
+			mockSetup:      func(m *mock_user.MockIUsecase) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"error":{"code":400,"message":"invalid query parameter: is_active"}}`,
+		},
+		{
+			name:           "invalid_limit",
+			query:          "?limit=ten",
+			mockSetup:      func(m *mock_user.MockIUsecase) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"error":{"code":400,"message":"invalid query parameter: limit"}}`,
+		},
+		{
+			name:  "success",
+			query: "?team_name=teamA&is_active=true&search=ali&limit=10&offset=0",
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				isActive := true
+				m.EXPECT().
+					ListUsers(mock.Anything, &entity.UserListFilter{TeamName: "teamA", IsActive: &isActive, Search: "ali", Limit: 10}).
+					Return(&entity.UserList{
+						Users: []*entity.User{{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}},
+						Total: 1,
+						Limit: 10,
+					}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantList: &entity.UserListResponse{UserList: &entity.UserList{
+				Users: []*entity.User{{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}},
+				Total: 1,
+				Limit: 10,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock_user.NewMockIUsecase(t)
+			if tt.mockSetup != nil {
+				tt.mockSetup(m)
+			}
+
+			h := NewHandler(m)
+
+			req := httptest.NewRequest(http.MethodGet, "/users/list"+tt.query, nil)
+			rr := httptest.NewRecorder()
+
+			http.HandlerFunc(h.ListUsers).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatusCode, rr.Code)
+
+			if tt.wantList != nil {
+				var got entity.UserListResponse
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				assert.Equal(t, tt.wantList, &got)
+			}
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, rr.Body.String())
+			}
+		})
+	}
+}
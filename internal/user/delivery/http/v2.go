@@ -0,0 +1,226 @@
+package http
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/httpquery"
+	json "github.com/Mockird31/avito_tech/pkg/json"
+	"github.com/asaskevich/govalidator"
+	"github.com/gorilla/mux"
+)
+
+// Обработчики /api/v2: пользователь адресуется идентификатором в пути, команда - именем,
+// ошибки разбора тела - 400, коды ошибок без исключений v1.
+
+func (h *Handler) CreateUserV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var userCreate entity.UserCreate
+	if err := json.ReadJSON(w, r, &userCreate); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	if _, err := govalidator.ValidateStruct(userCreate); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, err := h.usecase.CreateUser(ctx, &userCreate)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	headers := http.Header{"Location": {"/api/v2/users/" + url.PathEscape(user.UserId)}}
+	json.WriteJSON(w, http.StatusCreated, &entity.UserResponse{User: user}, headers)
+}
+
+func (h *Handler) GetUserV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	user, err := h.usecase.GetUser(ctx, mux.Vars(r)["id"])
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserResponse{User: user}, nil)
+}
+
+func (h *Handler) ListUsersV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := parseUserListFilter(r)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	userList, err := h.usecase.ListUsers(ctx, filter)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserListResponse{UserList: userList}, nil)
+}
+
+// PatchUserV2 объединяет /users/update и /users/setIsActive: имя, команда и активность
+// меняются в одной транзакции.
+func (h *Handler) PatchUserV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var userPatch entity.UserPatch
+	if err := json.ReadJSON(w, r, &userPatch); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+	userPatch.UserId = mux.Vars(r)["id"]
+
+	if userPatch.Username == nil && userPatch.TeamName == nil && userPatch.IsActive == nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, entity.ErrNothingToUpdate.Error())
+		return
+	}
+
+	if userPatch.Username != nil || userPatch.TeamName != nil {
+		userUpdate := &entity.UserUpdate{UserId: userPatch.UserId, Username: userPatch.Username, TeamName: userPatch.TeamName}
+		if _, err := govalidator.ValidateStruct(userUpdate); err != nil {
+			json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	user, err := h.usecase.PatchUser(ctx, &userPatch)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserResponse{User: user}, nil)
+}
+
+func (h *Handler) DeleteUserV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	deletedUser, err := h.usecase.DeleteUser(ctx, &entity.UserDelete{UserId: mux.Vars(r)["id"]})
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserDeleteResponse{DeletedUser: deletedUser}, nil)
+}
+
+func (h *Handler) GetUserReviewsV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := httpquery.PullRequestFilter(r.URL.Query())
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.ReviewerId = mux.Vars(r)["id"]
+
+	reviewerPullRequests, err := h.usecase.GetUserReview(ctx, filter)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, reviewerPullRequests, nil)
+}
+
+func (h *Handler) GetUserAuthoredV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	filter, err := httpquery.PullRequestFilter(r.URL.Query())
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.AuthorId = mux.Vars(r)["id"]
+
+	authorPullRequests, err := h.usecase.GetUserAuthored(ctx, filter)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, authorPullRequests, nil)
+}
+
+func (h *Handler) SetReviewCapacityV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var capacityRequest entity.UserReviewCapacity
+	if err := json.ReadJSON(w, r, &capacityRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, entity.ErrInvalidReviewCapacity.Error())
+		return
+	}
+	capacityRequest.UserId = mux.Vars(r)["id"]
+
+	if _, err := govalidator.ValidateStruct(capacityRequest); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	capacity, err := h.usecase.SetReviewCapacity(ctx, &capacityRequest)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.UserReviewCapacityResponse{Capacity: capacity}, nil)
+}
+
+func (h *Handler) DeactivateTeamUsersV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var deactivateUsers entity.DeactivateUsers
+	if err := json.ReadJSON(w, r, &deactivateUsers); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+	deactivateUsers.TeamName = mux.Vars(r)["name"]
+
+	if _, err := govalidator.ValidateStruct(deactivateUsers); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	deactivateUsersResp, err := h.usecase.DeactivateTeamUsers(ctx, &deactivateUsers)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.DeactivateUsersResponse{DeactivateUsers: deactivateUsersResp}, nil)
+}
+
+func (h *Handler) ReactivateTeamUsersV2(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var reactivateUsers entity.ReactivateUsers
+	if err := json.ReadJSON(w, r, &reactivateUsers); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+	reactivateUsers.TeamName = mux.Vars(r)["name"]
+
+	if _, err := govalidator.ValidateStruct(reactivateUsers); err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	reactivateUsersResp, err := h.usecase.ReactivateTeamUsers(ctx, &reactivateUsers)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatusV2(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.ReactivateUsersResponse{ReactivateUsers: reactivateUsersResp}, nil)
+}
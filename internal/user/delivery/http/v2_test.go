@@ -0,0 +1,115 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	mock_user "github.com/Mockird31/avito_tech/mocks/user"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_PatchUserV2(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		mockSetup      func(m *mock_user.MockIUsecase)
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "nothing_to_update",
+			body:           `{}`,
+			mockSetup:      func(m *mock_user.MockIUsecase) {},
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       `{"error":{"code":400,"message":"` + entity.ErrNothingToUpdate.Error() + `"}}`,
+		},
+		{
+			name: "only_is_active",
+			body: `{"is_active": false}`,
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				isActive := false
+				m.EXPECT().
+					PatchUser(mock.Anything, &entity.UserPatch{UserId: "u1", IsActive: &isActive}).
+					Return(&entity.User{UserId: "u1", Username: "alice", TeamName: "backend"}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"user":{"user_id":"u1","username":"alice","team_name":"backend","is_active":false}}`,
+		},
+		{
+			name: "username_and_is_active",
+			body: `{"username": "alicia", "is_active": true}`,
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				username := "alicia"
+				isActive := true
+				m.EXPECT().
+					PatchUser(mock.Anything, &entity.UserPatch{UserId: "u1", Username: &username, IsActive: &isActive}).
+					Return(&entity.User{UserId: "u1", Username: "alicia", TeamName: "backend", IsActive: true}, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       `{"user":{"user_id":"u1","username":"alicia","team_name":"backend","is_active":true}}`,
+		},
+		{
+			name: "user_not_found",
+			body: `{"team_name": "frontend"}`,
+			mockSetup: func(m *mock_user.MockIUsecase) {
+				m.EXPECT().
+					PatchUser(mock.Anything, mock.AnythingOfType("*entity.UserPatch")).
+					Return(nil, entity.ErrUserNotFound)
+			},
+			wantStatusCode: http.StatusNotFound,
+			wantBody:       `{"error":{"code":404,"message":"` + entity.ErrUserNotFound.Error() + `"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			m := mock_user.NewMockIUsecase(t)
+			tt.mockSetup(m)
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/v2/users/u1", bytes.NewBufferString(tt.body))
+			req = mux.SetURLVars(req, map[string]string{"id": "u1"})
+			rr := httptest.NewRecorder()
+
+			http.HandlerFunc(NewHandler(m).PatchUserV2).ServeHTTP(rr, req)
+
+			require.Equal(t, tt.wantStatusCode, rr.Code)
+			assert.JSONEq(t, tt.wantBody, rr.Body.String())
+		})
+	}
+}
+
+func TestHandler_CreateUserV2(t *testing.T) {
+	m := mock_user.NewMockIUsecase(t)
+	m.EXPECT().
+		CreateUser(mock.Anything, &entity.UserCreate{UserId: "u1", Username: "alice", TeamName: "backend", IsActive: true}).
+		Return(&entity.User{UserId: "u1", Username: "alice", TeamName: "backend", IsActive: true}, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v2/users", bytes.NewBufferString(`{"user_id":"u1","username":"alice","team_name":"backend","is_active":true}`))
+	rr := httptest.NewRecorder()
+
+	http.HandlerFunc(NewHandler(m).CreateUserV2).ServeHTTP(rr, req)
+
+	require.Equal(t, http.StatusCreated, rr.Code)
+	assert.Equal(t, "/api/v2/users/u1", rr.Header().Get("Location"))
+}
+
+func TestHandler_GetUserV2_InternalError(t *testing.T) {
+	m := mock_user.NewMockIUsecase(t)
+	m.EXPECT().GetUser(mock.Anything, "u1").Return(nil, errors.New("db failure"))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v2/users/u1", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": "u1"})
+	rr := httptest.NewRecorder()
+
+	http.HandlerFunc(NewHandler(m).GetUserV2).ServeHTTP(rr, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rr.Code)
+}
@@ -8,6 +8,7 @@ import (
 
 type IRepository interface {
 	GetExistentUsers(ctx context.Context, membersIds []string) (map[string]struct{}, error)
+	GetDeletedUserIds(ctx context.Context, ids []string) ([]string, error)
 	CreateUsers(ctx context.Context, users []*entity.TeamMember, teamName string) error
 	UpdateUsersTeam(ctx context.Context, users []*entity.TeamMember, teamName string) error
 	GetMembersByTeamName(ctx context.Context, teamName string) ([]*entity.TeamMember, error)
@@ -20,4 +21,16 @@ type IRepository interface {
 
 	FindNewReviewerExcluding(ctx context.Context, prId string, authorId string, excludeUserIDs []string) (string, error)
 	UpdateUsersIsActiveByIds(ctx context.Context, ids []string, isActive bool) error
+
+	CountCandidatesAtCapacity(ctx context.Context, authorId string) (int, error)
+	ExplainReviewerCandidates(ctx context.Context, prId string, authorId string) (*entity.CandidateExclusions, error)
+	CheckBelowReviewCapacity(ctx context.Context, userId string) (bool, error)
+	SetReviewCapacity(ctx context.Context, userId string, maxOpenReviews *int) error
+	CreateUser(ctx context.Context, user *entity.UserCreate) error
+	UpdateUser(ctx context.Context, userId string, username *string, teamName *string) error
+	SoftDeleteUser(ctx context.Context, userId string, anonymizedUsername string) error
+	ListUsers(ctx context.Context, filter *entity.UserListFilter) ([]*entity.User, error)
+	CountUsers(ctx context.Context, filter *entity.UserListFilter) (int, error)
+	GetReviewCandidatesByTeams(ctx context.Context, teamNames []string, excludeUserIds []string) ([]*entity.ReviewCandidate, error)
+	GetReviewLoadsByIds(ctx context.Context, userIds []string) ([]*entity.ReviewCandidate, error)
 }
@@ -10,36 +10,60 @@ import (
 	"github.com/Mockird31/avito_tech/internal/entity"
 	"github.com/Mockird31/avito_tech/internal/user"
 	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/Mockird31/avito_tech/pkg/postgres"
 	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
+const (
+	// OpenReviewsCountSubquery считает открытые pull request'ы, на которые назначен пользователь u.
+	OpenReviewsCountSubquery = `(
+            SELECT COUNT(*)
+            FROM pull_request_reviewers prr
+            JOIN pull_request p ON p.id = prr.pull_request_id
+            WHERE prr.reviewer_id = u.id AND p.status = 'OPEN'
+          )`
+	// BelowCapacityCondition отсекает кандидатов, достигших лимита открытых ревью (лимит пользователя приоритетнее лимита команды t).
+	BelowCapacityCondition = `(
+            COALESCE(u.max_open_reviews, t.max_open_reviews) IS NULL
+            OR ` + OpenReviewsCountSubquery + ` < COALESCE(u.max_open_reviews, t.max_open_reviews)
+          )`
+)
+
 const (
 	GetExistentUsersQuery = `
 		SELECT id
 		FROM "user"
-		WHERE id = ANY($1);
+		WHERE id = ANY($1) AND deleted_at IS NULL;
+	`
+	GetDeletedUserIdsQuery = `
+		SELECT id
+		FROM "user"
+		WHERE id = ANY($1) AND deleted_at IS NOT NULL
+		ORDER BY id;
 	`
 	UpdateUsersTeamQuery = `
 		UPDATE "user"
 		SET team_name = $1, updated_at = NOW()
-		WHERE id = ANY($2);
+		WHERE id = ANY($2) AND deleted_at IS NULL;
 	`
 	GetMembersByTeamNameQuery = `
 		SELECT id, username, is_active
 		FROM "user"
-		WHERE team_name = $1;
+		WHERE team_name = $1 AND deleted_at IS NULL;
 	`
 	UpdateUserActiveQuery = `
 		UPDATE "user"
 		SET is_active = $1, updated_at = NOW()
-		WHERE id = $2;
+		WHERE id = $2 AND deleted_at IS NULL;
 	`
 	CheckUserExistByIdQuery = `
 		SELECT 1
 		FROM "user"
-		WHERE id = $1;
+		WHERE id = $1 AND deleted_at IS NULL;
 	`
+	// GetUserByIdQuery находит и удаленных пользователей: по нему берется команда автора существующих
+	// pull request'ов. Обработчики API перед ним проверяют пользователя через CheckUserExistByIdQuery.
 	GetUserByIdQuery = `
 		SELECT id, username, team_name, is_active
 		FROM "user"
@@ -48,19 +72,23 @@ const (
 	FindReviewersQuery = `
         SELECT u.id
         FROM "user" u
+        LEFT JOIN team t ON t.name = u.team_name
         WHERE u.team_name = (SELECT team_name FROM "user" WHERE id = $1)
           AND u.id <> $1
           AND u.is_active = TRUE
+          AND ` + BelowCapacityCondition + `
         ORDER BY random()
         LIMIT 2;
     `
 	FindNewReviewerQuery = `
         SELECT u.id
         FROM "user" u
+        LEFT JOIN team t ON t.name = u.team_name
         WHERE u.team_name = (SELECT team_name FROM "user" WHERE id = $1)
           AND u.id <> $1
           AND u.id <> $3
           AND u.is_active = TRUE
+          AND ` + BelowCapacityCondition + `
           AND u.id NOT IN (
               SELECT reviewer_id
               FROM pull_request_reviewers
@@ -72,20 +100,22 @@ const (
 	GetUsersByIdsQuery = `
         SELECT id, username, team_name, is_active
         FROM "user"
-        WHERE id = ANY($1);
+        WHERE id = ANY($1) AND deleted_at IS NULL;
     `
 	UpdateUsersIsActiveByIdsQuery = `
         UPDATE "user"
         SET is_active = $1, updated_at = NOW()
-        WHERE id = ANY($2);
+        WHERE id = ANY($2) AND deleted_at IS NULL;
     `
 	FindNewReviewerExcludingQuery = `
         SELECT u.id
         FROM "user" u
+        LEFT JOIN team t ON t.name = u.team_name
         WHERE u.team_name = (SELECT team_name FROM "user" WHERE id = $1)
           AND u.id <> $1
           AND u.is_active = TRUE
           AND NOT (u.id = ANY($3))
+          AND ` + BelowCapacityCondition + `
           AND u.id NOT IN (
               SELECT reviewer_id
               FROM pull_request_reviewers
@@ -94,8 +124,105 @@ const (
         ORDER BY random()
         LIMIT 1;
     `
+	CountCandidatesAtCapacityQuery = `
+        SELECT COUNT(*)
+        FROM "user" u
+        LEFT JOIN team t ON t.name = u.team_name
+        WHERE u.team_name = (SELECT team_name FROM "user" WHERE id = $1)
+          AND u.id <> $1
+          AND u.is_active = TRUE
+          AND NOT ` + BelowCapacityCondition + `;
+    `
+	// ExplainReviewerCandidatesQuery раскладывает участников команды автора по первой причине,
+	// по которой FindNewReviewerQuery их отсеивает.
+	ExplainReviewerCandidatesQuery = `
+        SELECT
+            CASE
+                WHEN u.id = $1 THEN 'author'
+                WHEN u.is_active = FALSE THEN 'inactive'
+                WHEN u.id IN (
+                    SELECT reviewer_id
+                    FROM pull_request_reviewers
+                    WHERE pull_request_id = $2
+                ) THEN 'already_assigned'
+                WHEN NOT ` + BelowCapacityCondition + ` THEN 'at_capacity'
+                ELSE 'eligible'
+            END AS reason,
+            COUNT(*)
+        FROM "user" u
+        LEFT JOIN team t ON t.name = u.team_name
+        WHERE u.team_name = (SELECT team_name FROM "user" WHERE id = $1)
+          AND u.deleted_at IS NULL
+        GROUP BY reason;
+    `
+	CheckBelowReviewCapacityQuery = `
+        SELECT ` + BelowCapacityCondition + `
+        FROM "user" u
+        LEFT JOIN team t ON t.name = u.team_name
+        WHERE u.id = $1;
+    `
+	GetReviewCandidatesByTeamsQuery = `
+        SELECT u.id, u.team_name, ` + OpenReviewsCountSubquery + `, COALESCE(u.max_open_reviews, t.max_open_reviews)
+        FROM "user" u
+        LEFT JOIN team t ON t.name = u.team_name
+        WHERE u.team_name = ANY($1)
+          AND u.is_active = TRUE
+          AND NOT (u.id = ANY($2))
+        ORDER BY u.id;
+    `
+	// GetReviewLoadsByIdsQuery - текущая нагрузка и лимит открытых ревью пользователей независимо от активности,
+	// для тех, кого еще только собираются активировать.
+	GetReviewLoadsByIdsQuery = `
+        SELECT u.id, u.team_name, ` + OpenReviewsCountSubquery + `, COALESCE(u.max_open_reviews, t.max_open_reviews)
+        FROM "user" u
+        LEFT JOIN team t ON t.name = u.team_name
+        WHERE u.id = ANY($1)
+        ORDER BY u.id;
+    `
+	CreateUserQuery = `
+        INSERT INTO "user" (id, username, team_name, is_active)
+        VALUES ($1, $2, $3, $4)
+        ON CONFLICT (id) DO NOTHING;
+    `
+	UpdateUserQuery = `
+        UPDATE "user"
+        SET username = COALESCE($1, username),
+            team_name = COALESCE($2, team_name),
+            updated_at = NOW()
+        WHERE id = $3 AND deleted_at IS NULL;
+    `
+	ListUsersQuery = `
+        SELECT id, username, team_name, is_active
+        FROM "user"
+        WHERE deleted_at IS NULL
+          AND ($1 = '' OR team_name = $1)
+          AND ($2::boolean IS NULL OR is_active = $2)
+          AND ($3 = '' OR username ILIKE '%' || $3 || '%')
+        ORDER BY id
+        LIMIT $4 OFFSET $5;
+    `
+	CountUsersQuery = `
+        SELECT COUNT(*)
+        FROM "user"
+        WHERE deleted_at IS NULL
+          AND ($1 = '' OR team_name = $1)
+          AND ($2::boolean IS NULL OR is_active = $2)
+          AND ($3 = '' OR username ILIKE '%' || $3 || '%');
+    `
+	SoftDeleteUserQuery = `
+        UPDATE "user"
+        SET username = $1, is_active = FALSE, deleted_at = NOW(), updated_at = NOW()
+        WHERE id = $2 AND deleted_at IS NULL;
+    `
+	SetReviewCapacityQuery = `
+        UPDATE "user"
+        SET max_open_reviews = $1, updated_at = NOW()
+        WHERE id = $2 AND deleted_at IS NULL;
+    `
 )
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 type repository struct {
 	db *sql.DB
 }
@@ -110,7 +237,7 @@ func (r *repository) GetExistentUsers(ctx context.Context, membersIds []string)
 	logger := loggerPkg.LoggerFromContext(ctx)
 	existingUsersMap := make(map[string]struct{})
 
-	rows, err := r.db.QueryContext(ctx, GetExistentUsersQuery, pq.Array(membersIds))
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetExistentUsersQuery, pq.Array(membersIds))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("existent users not found")
@@ -146,6 +273,40 @@ func (r *repository) GetExistentUsers(ctx context.Context, membersIds []string)
 	return existingUsersMap, nil
 }
 
+// GetDeletedUserIds возвращает идентификаторы из ids, принадлежащие удаленным пользователям:
+// их нельзя ни создать заново, ни перенести в другую команду.
+func (r *repository) GetDeletedUserIds(ctx context.Context, ids []string) (deletedIds []string, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetDeletedUserIdsQuery, pq.Array(ids))
+	if err != nil {
+		logger.Error("failed to get deleted users (GetDeletedUserIds)", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (GetDeletedUserIds)", zap.Error(err))
+		}
+	}()
+
+	deletedIds = make([]string, 0)
+	for rows.Next() {
+		var userId string
+		if err := rows.Scan(&userId); err != nil {
+			logger.Error("failed to scan (GetDeletedUserIds)", zap.Error(err))
+			return nil, err
+		}
+		deletedIds = append(deletedIds, userId)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to iterate through rows (GetDeletedUserIds)", zap.Error(err))
+		return nil, err
+	}
+	return deletedIds, nil
+}
+
 func PrepareCreateUsersQuery(users []*entity.TeamMember, teamName string) (string, []any, error) {
 	var sb strings.Builder
 
@@ -182,7 +343,7 @@ func (r *repository) CreateUsers(ctx context.Context, users []*entity.TeamMember
 		logger.Error("failed to prepare query (CreateUsers):", zap.Error(err))
 		return err
 	}
-	_, err = r.db.ExecContext(ctx, query, args...)
+	_, err = postgres.Conn(ctx, r.db).ExecContext(ctx, query, args...)
 	if err != nil {
 		logger.Error("failed to create users (CreateUsers):", zap.Error(err))
 		return err
@@ -197,7 +358,7 @@ func (r *repository) UpdateUsersTeam(ctx context.Context, users []*entity.TeamMe
 		ids = append(ids, u.UserID)
 	}
 
-	_, err := r.db.ExecContext(ctx, UpdateUsersTeamQuery, teamName, pq.Array(ids))
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, UpdateUsersTeamQuery, teamName, pq.Array(ids))
 	if err != nil {
 		logger.Error("failed to update users team (UpdateUsersTeam):", zap.Error(err))
 		return err
@@ -207,7 +368,7 @@ func (r *repository) UpdateUsersTeam(ctx context.Context, users []*entity.TeamMe
 
 func (r *repository) GetMembersByTeamName(ctx context.Context, teamName string) ([]*entity.TeamMember, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
-	rows, err := r.db.QueryContext(ctx, GetMembersByTeamNameQuery, teamName)
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetMembersByTeamNameQuery, teamName)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, entity.ErrTeamNoMembersByTeam
@@ -246,7 +407,7 @@ func (r *repository) GetMembersByTeamName(ctx context.Context, teamName string)
 func (r *repository) CheckUserExistById(ctx context.Context, userId string) (bool, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	var isExist bool
-	err := r.db.QueryRowContext(ctx, CheckUserExistByIdQuery, userId).Scan(&isExist)
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, CheckUserExistByIdQuery, userId).Scan(&isExist)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("user not found (CheckUserExistById)", zap.String("user_id", userId))
@@ -260,7 +421,7 @@ func (r *repository) CheckUserExistById(ctx context.Context, userId string) (boo
 
 func (r *repository) SetIsActive(ctx context.Context, userId string, isActive bool) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
-	_, err := r.db.ExecContext(ctx, UpdateUserActiveQuery, isActive, userId)
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, UpdateUserActiveQuery, isActive, userId)
 	if err != nil {
 		logger.Error("failed to update user is_active (SetIsActive)", zap.Error(err))
 		return err
@@ -273,7 +434,7 @@ func (r *repository) GetUserById(ctx context.Context, userId string) (*entity.Us
 
 	var user entity.User
 
-	err := r.db.QueryRowContext(ctx, GetUserByIdQuery, userId).Scan(&user.UserId, &user.Username, &user.TeamName, &user.IsActive)
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, GetUserByIdQuery, userId).Scan(&user.UserId, &user.Username, &user.TeamName, &user.IsActive)
 	if err != nil {
 		logger.Error("failed to get user by id (GetUserById)", zap.Error(err))
 		return nil, err
@@ -285,7 +446,7 @@ func (r *repository) GetUserById(ctx context.Context, userId string) (*entity.Us
 func (r *repository) FindReviewers(ctx context.Context, authorId string) ([]string, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
-	rows, err := r.db.QueryContext(ctx, FindReviewersQuery, authorId)
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, FindReviewersQuery, authorId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("reviewers not found (FindReviewers)", "author_id", authorId)
@@ -326,7 +487,7 @@ func (r *repository) FindNewReviewer(ctx context.Context, prId, authorId, oldRev
 	logger := loggerPkg.LoggerFromContext(ctx)
 
 	var reviewerId string
-	err := r.db.QueryRowContext(ctx, FindNewReviewerQuery, authorId, prId, oldReviewerId).Scan(&reviewerId)
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, FindNewReviewerQuery, authorId, prId, oldReviewerId).Scan(&reviewerId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("no available reviewer (FindNewReviewer)", zap.String("pr_id", prId), zap.String("author_id", authorId), zap.String("exclude_user_id", oldReviewerId))
@@ -342,7 +503,7 @@ func (r *repository) FindNewReviewer(ctx context.Context, prId, authorId, oldRev
 func (r *repository) GetUsersByIds(ctx context.Context, userIds []string) (map[string]*entity.User, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
-	rows, err := r.db.QueryContext(ctx, GetUsersByIdsQuery, pq.Array(userIds))
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetUsersByIdsQuery, pq.Array(userIds))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return map[string]*entity.User{}, nil
@@ -375,7 +536,7 @@ func (r *repository) GetUsersByIds(ctx context.Context, userIds []string) (map[s
 
 func (r *repository) UpdateUsersIsActiveByIds(ctx context.Context, ids []string, isActive bool) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
-	_, err := r.db.ExecContext(ctx, UpdateUsersIsActiveByIdsQuery, isActive, pq.Array(ids))
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, UpdateUsersIsActiveByIdsQuery, isActive, pq.Array(ids))
 	if err != nil {
 		logger.Error("failed to bulk update is_active (UpdateUsersIsActiveByIds)", zap.Error(err))
 		return err
@@ -387,7 +548,7 @@ func (r *repository) FindNewReviewerExcluding(ctx context.Context, prId, authorI
 	logger := loggerPkg.LoggerFromContext(ctx)
 
 	var reviewerId string
-	err := r.db.QueryRowContext(ctx, FindNewReviewerExcludingQuery, authorId, prId, pq.Array(excludeUserIDs)).Scan(&reviewerId)
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, FindNewReviewerExcludingQuery, authorId, prId, pq.Array(excludeUserIDs)).Scan(&reviewerId)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			logger.Info("no available reviewer (FindNewReviewerExcluding)", zap.String("pr_id", prId), zap.String("author_id", authorId))
@@ -398,3 +559,217 @@ func (r *repository) FindNewReviewerExcluding(ctx context.Context, prId, authorI
 	}
 	return reviewerId, nil
 }
+
+func (r *repository) CountCandidatesAtCapacity(ctx context.Context, authorId string) (int, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var count int
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, CountCandidatesAtCapacityQuery, authorId).Scan(&count)
+	if err != nil {
+		logger.Error("failed to count candidates at capacity (CountCandidatesAtCapacity)", zap.Error(err), zap.String("author_id", authorId))
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *repository) CheckBelowReviewCapacity(ctx context.Context, userId string) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var isBelow bool
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, CheckBelowReviewCapacityQuery, userId).Scan(&isBelow)
+	if err != nil {
+		logger.Error("failed to check review capacity (CheckBelowReviewCapacity)", zap.Error(err), zap.String("user_id", userId))
+		return false, err
+	}
+	return isBelow, nil
+}
+
+func (r *repository) SetReviewCapacity(ctx context.Context, userId string, maxOpenReviews *int) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, SetReviewCapacityQuery, maxOpenReviews, userId)
+	if err != nil {
+		logger.Error("failed to update user max_open_reviews (SetReviewCapacity)", zap.Error(err), zap.String("user_id", userId))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) GetReviewCandidatesByTeams(ctx context.Context, teamNames []string, excludeUserIds []string) ([]*entity.ReviewCandidate, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetReviewCandidatesByTeamsQuery, pq.Array(teamNames), pq.Array(excludeUserIds))
+	if err != nil {
+		logger.Error("failed to get review candidates (GetReviewCandidatesByTeams)", zap.Error(err))
+		return nil, err
+	}
+	return scanReviewCandidates(ctx, rows)
+}
+
+func (r *repository) GetReviewLoadsByIds(ctx context.Context, userIds []string) ([]*entity.ReviewCandidate, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, GetReviewLoadsByIdsQuery, pq.Array(userIds))
+	if err != nil {
+		logger.Error("failed to get review loads (GetReviewLoadsByIds)", zap.Error(err), zap.Strings("user_ids", userIds))
+		return nil, err
+	}
+	return scanReviewCandidates(ctx, rows)
+}
+
+func scanReviewCandidates(ctx context.Context, rows *sql.Rows) (candidates []*entity.ReviewCandidate, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = cerr
+			logger.Error("failed to close rows (scanReviewCandidates)", zap.Error(err))
+		}
+	}()
+
+	candidates = make([]*entity.ReviewCandidate, 0)
+	for rows.Next() {
+		var c entity.ReviewCandidate
+		var maxOpenReviews sql.NullInt64
+		if err := rows.Scan(&c.UserId, &c.TeamName, &c.OpenReviews, &maxOpenReviews); err != nil {
+			logger.Error("scan error (scanReviewCandidates)", zap.Error(err))
+			return nil, err
+		}
+		if maxOpenReviews.Valid {
+			limit := int(maxOpenReviews.Int64)
+			c.MaxOpenReviews = &limit
+		}
+		candidates = append(candidates, &c)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (scanReviewCandidates)", zap.Error(err))
+		return nil, err
+	}
+	return candidates, nil
+}
+
+func (r *repository) CreateUser(ctx context.Context, user *entity.UserCreate) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	res, err := postgres.Conn(ctx, r.db).ExecContext(ctx, CreateUserQuery, user.UserId, user.Username, user.TeamName, user.IsActive)
+	if err != nil {
+		logger.Error("failed to create user (CreateUser)", zap.Error(err), zap.String("user_id", user.UserId))
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.Error("failed to get rows affected (CreateUser)", zap.Error(err))
+		return err
+	}
+
+	// id удаленного пользователя остается занятым
+	if affected == 0 {
+		return entity.ErrUserExist
+	}
+	return nil
+}
+
+func (r *repository) UpdateUser(ctx context.Context, userId string, username *string, teamName *string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, UpdateUserQuery, username, teamName, userId)
+	if err != nil {
+		logger.Error("failed to update user (UpdateUser)", zap.Error(err), zap.String("user_id", userId))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) ListUsers(ctx context.Context, filter *entity.UserListFilter) ([]*entity.User, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, ListUsersQuery, filter.TeamName, filter.IsActive, likeEscaper.Replace(filter.Search), filter.Limit, filter.Offset)
+	if err != nil {
+		logger.Error("failed to list users (ListUsers)", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = cerr
+			logger.Error("failed to close rows (ListUsers)", zap.Error(err))
+		}
+	}()
+
+	users := make([]*entity.User, 0)
+	for rows.Next() {
+		var u entity.User
+		if err := rows.Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+			logger.Error("scan error (ListUsers)", zap.Error(err))
+			return nil, err
+		}
+		users = append(users, &u)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (ListUsers)", zap.Error(err))
+		return nil, err
+	}
+	return users, nil
+}
+
+func (r *repository) CountUsers(ctx context.Context, filter *entity.UserListFilter) (int, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var total int
+	err := postgres.Conn(ctx, r.db).QueryRowContext(ctx, CountUsersQuery, filter.TeamName, filter.IsActive, likeEscaper.Replace(filter.Search)).Scan(&total)
+	if err != nil {
+		logger.Error("failed to count users (CountUsers)", zap.Error(err))
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *repository) SoftDeleteUser(ctx context.Context, userId string, anonymizedUsername string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	_, err := postgres.Conn(ctx, r.db).ExecContext(ctx, SoftDeleteUserQuery, anonymizedUsername, userId)
+	if err != nil {
+		logger.Error("failed to soft delete user (SoftDeleteUser)", zap.Error(err), zap.String("user_id", userId))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) ExplainReviewerCandidates(ctx context.Context, prId, authorId string) (*entity.CandidateExclusions, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := postgres.Conn(ctx, r.db).QueryContext(ctx, ExplainReviewerCandidatesQuery, authorId, prId)
+	if err != nil {
+		logger.Error("failed to explain reviewer candidates (ExplainReviewerCandidates)", zap.Error(err), zap.String("pr_id", prId))
+		return nil, err
+	}
+	defer func() {
+		if cerr := rows.Close(); cerr != nil && err == nil {
+			err = cerr
+			logger.Error("failed to close rows (ExplainReviewerCandidates)", zap.Error(err))
+		}
+	}()
+
+	exclusions := &entity.CandidateExclusions{}
+	for rows.Next() {
+		var (
+			reason string
+			count  int
+		)
+		if err := rows.Scan(&reason, &count); err != nil {
+			logger.Error("scan error (ExplainReviewerCandidates)", zap.Error(err))
+			return nil, err
+		}
+		switch reason {
+		case "author":
+			exclusions.Author = count
+		case "inactive":
+			exclusions.Inactive = count
+		case "already_assigned":
+			exclusions.AlreadyAssigned = count
+		case "at_capacity":
+			exclusions.AtCapacity = count
+		}
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (ExplainReviewerCandidates)", zap.Error(err))
+		return nil, err
+	}
+	return exclusions, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Mockird31/avito_tech/internal/entity"
 	"github.com/Mockird31/avito_tech/internal/user"
 	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
@@ -56,6 +57,22 @@ func TestGetExistentUsers_Success(t *testing.T) {
 	require.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetDeletedUserIds_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetDeletedUserIdsQuery)).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("u2"))
+
+	got, err := repo.GetDeletedUserIds(ctx, []string{"u1", "u2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetExistentUsers_EmptyResult(t *testing.T) {
 	db, mock, repo := setupTest(t)
 	defer db.Close()
@@ -682,3 +699,253 @@ func TestFindNewReviewerExcluding_DBError(t *testing.T) {
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestCountCandidatesAtCapacity_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(2)
+	mock.ExpectQuery(regexp.QuoteMeta(CountCandidatesAtCapacityQuery)).
+		WithArgs("author1").
+		WillReturnRows(rows)
+
+	count, err := repo.CountCandidatesAtCapacity(ctx, "author1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountCandidatesAtCapacity_DBError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	dbErr := errors.New("db failure")
+	mock.ExpectQuery(regexp.QuoteMeta(CountCandidatesAtCapacityQuery)).
+		WithArgs("author1").
+		WillReturnError(dbErr)
+
+	count, err := repo.CountCandidatesAtCapacity(ctx, "author1")
+	require.Error(t, err)
+	assert.Zero(t, count)
+	assert.EqualError(t, err, dbErr.Error())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetReviewCapacity_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	limit := 4
+	mock.ExpectExec(regexp.QuoteMeta(SetReviewCapacityQuery)).
+		WithArgs(limit, "u1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetReviewCapacity(ctx, "u1", &limit)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetReviewCapacity_DBError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	dbErr := errors.New("update failed")
+	mock.ExpectExec(regexp.QuoteMeta(SetReviewCapacityQuery)).
+		WithArgs(nil, "u1").
+		WillReturnError(dbErr)
+
+	err := repo.SetReviewCapacity(ctx, "u1", nil)
+	require.Error(t, err)
+	assert.EqualError(t, err, dbErr.Error())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateUser_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	u := &entity.UserCreate{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}
+
+	mock.ExpectExec(regexp.QuoteMeta(CreateUserQuery)).
+		WithArgs("u1", "alice", "teamA", true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.CreateUser(ctx, u)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateUser_OnlyUsername(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	username := "bob"
+
+	mock.ExpectExec(regexp.QuoteMeta(UpdateUserQuery)).
+		WithArgs("bob", nil, "u1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.UpdateUser(ctx, "u1", &username, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListUsers_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	isActive := true
+	filter := &entity.UserListFilter{TeamName: "teamA", IsActive: &isActive, Search: "a_l%", Limit: 10, Offset: 5}
+
+	rows := sqlmock.NewRows([]string{"id", "username", "team_name", "is_active"}).
+		AddRow("u1", "a_l%ice", "teamA", true)
+
+	mock.ExpectQuery(regexp.QuoteMeta(ListUsersQuery)).
+		WithArgs("teamA", true, `a\_l\%`, 10, 5).
+		WillReturnRows(rows)
+
+	users, err := repo.ListUsers(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, []*entity.User{{UserId: "u1", Username: "a_l%ice", TeamName: "teamA", IsActive: true}}, users)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountUsers_NoFilters(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(CountUsersQuery)).
+		WithArgs("", nil, "").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(42))
+
+	total, err := repo.CountUsers(ctx, &entity.UserListFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 42, total)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateUser_IdTaken(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	u := &entity.UserCreate{UserId: "u1", Username: "alice", TeamName: "teamA"}
+
+	mock.ExpectExec(regexp.QuoteMeta(CreateUserQuery)).
+		WithArgs("u1", "alice", "teamA", false).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.CreateUser(ctx, u)
+	assert.ErrorIs(t, err, entity.ErrUserExist)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSoftDeleteUser_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(SoftDeleteUserQuery)).
+		WithArgs(entity.DeletedUsername, "u1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SoftDeleteUser(ctx, "u1", entity.DeletedUsername)
+	require.NoError(t, err)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCheckBelowReviewCapacity(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(CheckBelowReviewCapacityQuery)).
+		WithArgs("u1").
+		WillReturnRows(sqlmock.NewRows([]string{"below"}).AddRow(false))
+
+	isBelow, err := repo.CheckBelowReviewCapacity(ctx, "u1")
+	require.NoError(t, err)
+	assert.False(t, isBelow)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExplainReviewerCandidates_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"reason", "count"}).
+		AddRow("author", 1).
+		AddRow("inactive", 2).
+		AddRow("already_assigned", 2).
+		AddRow("at_capacity", 1)
+	mock.ExpectQuery(regexp.QuoteMeta(ExplainReviewerCandidatesQuery)).
+		WithArgs("author1", "pr1").
+		WillReturnRows(rows)
+
+	got, err := repo.ExplainReviewerCandidates(ctx, "pr1", "author1")
+	require.NoError(t, err)
+	assert.Equal(t, &entity.CandidateExclusions{Author: 1, Inactive: 2, AlreadyAssigned: 2, AtCapacity: 1}, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestExplainReviewerCandidates_DBError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	dbErr := errors.New("db failure")
+	mock.ExpectQuery(regexp.QuoteMeta(ExplainReviewerCandidatesQuery)).
+		WithArgs("author1", "pr1").
+		WillReturnError(dbErr)
+
+	got, err := repo.ExplainReviewerCandidates(ctx, "pr1", "author1")
+	require.Error(t, err)
+	assert.Nil(t, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetReviewLoadsByIds_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	rows := sqlmock.NewRows([]string{"id", "team_name", "open_reviews", "max_open_reviews"}).
+		AddRow("u4", "teamA", 2, 3).
+		AddRow("u5", "teamA", 0, nil)
+	mock.ExpectQuery(regexp.QuoteMeta(GetReviewLoadsByIdsQuery)).
+		WithArgs(pq.Array([]string{"u4", "u5"})).
+		WillReturnRows(rows)
+
+	loads, err := repo.GetReviewLoadsByIds(ctx, []string{"u4", "u5"})
+	require.NoError(t, err)
+
+	limit := 3
+	assert.Equal(t, []*entity.ReviewCandidate{
+		{UserId: "u4", TeamName: "teamA", OpenReviews: 2, MaxOpenReviews: &limit},
+		{UserId: "u5", TeamName: "teamA"},
+	}, loads)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -8,6 +8,15 @@ import (
 
 type IUsecase interface {
 	SetIsActive(ctx context.Context, userUpdateActive *entity.UserUpdateActive) (*entity.User, error)
-	GetUserReview(ctx context.Context, userId string) ([]*entity.PullRequestShort, string, error)
+	GetUserReview(ctx context.Context, filter *entity.PullRequestFilter) (*entity.ReviewerPullRequests, error)
+	GetUserAuthored(ctx context.Context, filter *entity.PullRequestFilter) (*entity.AuthorPullRequests, error)
 	DeactivateTeamUsers(ctx context.Context, deactivateUsers *entity.DeactivateUsers) (*entity.DeactivateUsers, error)
+	ReactivateTeamUsers(ctx context.Context, reactivateUsers *entity.ReactivateUsers) (*entity.ReactivateUsersResult, error)
+	CreateUser(ctx context.Context, userCreate *entity.UserCreate) (*entity.User, error)
+	UpdateUser(ctx context.Context, userUpdate *entity.UserUpdate) (*entity.User, error)
+	PatchUser(ctx context.Context, userPatch *entity.UserPatch) (*entity.User, error)
+	DeleteUser(ctx context.Context, userDelete *entity.UserDelete) (*entity.UserDeleteResult, error)
+	GetUser(ctx context.Context, userId string) (*entity.User, error)
+	ListUsers(ctx context.Context, filter *entity.UserListFilter) (*entity.UserList, error)
+	SetReviewCapacity(ctx context.Context, capacity *entity.UserReviewCapacity) (*entity.UserReviewCapacity, error)
 }
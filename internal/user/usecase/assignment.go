@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"sort"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// planDeactivation подбирает замену каждому деактивируемому ревьюверу открытых pull request'ов.
+// assignments - все назначения затронутых pull request'ов (включая оставшихся ревьюверов),
+// candidates - активные участники команд авторов без деактивируемых пользователей.
+// Замена выбирается среди участников команды автора с наименьшей текущей нагрузкой,
+// поэтому ревью распределяются равномерно.
+func planDeactivation(assignments []*entity.ReviewAssignment, candidates []*entity.ReviewCandidate, deactivatedIds []string) []*entity.ReviewerReassignment {
+	deactivated := make(map[string]struct{}, len(deactivatedIds))
+	for _, id := range deactivatedIds {
+		deactivated[id] = struct{}{}
+	}
+
+	poolByTeam := make(map[string][]*entity.ReviewCandidate)
+	for _, c := range candidates {
+		poolByTeam[c.TeamName] = append(poolByTeam[c.TeamName], c)
+	}
+	for _, pool := range poolByTeam {
+		sort.Slice(pool, func(i, j int) bool { return pool[i].UserId < pool[j].UserId })
+	}
+
+	reviewersByPr := make(map[string]map[string]struct{})
+	for _, a := range assignments {
+		if reviewersByPr[a.PullRequestId] == nil {
+			reviewersByPr[a.PullRequestId] = make(map[string]struct{})
+		}
+		reviewersByPr[a.PullRequestId][a.ReviewerId] = struct{}{}
+	}
+
+	reassignments := make([]*entity.ReviewerReassignment, 0)
+	for _, a := range assignments {
+		if _, ok := deactivated[a.ReviewerId]; !ok {
+			continue
+		}
+
+		reassignment := &entity.ReviewerReassignment{
+			PullRequestId: a.PullRequestId,
+			OldReviewerId: a.ReviewerId,
+			Result:        entity.ReassignmentNoCandidate,
+		}
+
+		chosen, reason := pickLeastLoaded(poolByTeam[a.TeamName], a.AuthorId, reviewersByPr[a.PullRequestId])
+		if chosen == nil {
+			reassignment.Reason = reason
+		} else {
+			chosen.OpenReviews++
+			reviewersByPr[a.PullRequestId][chosen.UserId] = struct{}{}
+
+			newReviewerId := chosen.UserId
+			reassignment.NewReviewerId = &newReviewerId
+			reassignment.Result = entity.ReassignmentReassigned
+			reassignment.Reason = entity.ReassignmentReasonReplaced
+		}
+		reassignments = append(reassignments, reassignment)
+	}
+
+	return reassignments
+}
+
+// pickLeastLoaded возвращает наименее загруженного кандидата, который не является автором,
+// еще не назначен на pull request и не достиг лимита открытых ревью. Если такого нет,
+// возвращается причина отказа.
+func pickLeastLoaded(pool []*entity.ReviewCandidate, authorId string, assigned map[string]struct{}) (*entity.ReviewCandidate, string) {
+	if len(pool) == 0 {
+		return nil, entity.ReassignmentReasonNoActiveMembers
+	}
+
+	var chosen *entity.ReviewCandidate
+	eligible, alreadyAssigned := 0, 0
+	for _, c := range pool {
+		if c.UserId == authorId {
+			continue
+		}
+		if _, ok := assigned[c.UserId]; ok {
+			alreadyAssigned++
+			continue
+		}
+		eligible++
+		if c.MaxOpenReviews != nil && c.OpenReviews >= *c.MaxOpenReviews {
+			continue
+		}
+		if chosen == nil || c.OpenReviews < chosen.OpenReviews {
+			chosen = c
+		}
+	}
+
+	if chosen != nil {
+		return chosen, ""
+	}
+	if eligible == 0 && alreadyAssigned == 0 {
+		return nil, entity.ReassignmentReasonOnlyAuthor
+	}
+	if eligible == 0 {
+		return nil, entity.ReassignmentReasonAllAssigned
+	}
+	return nil, entity.ReassignmentReasonAtCapacity
+}
+
+// planRebalance подбирает переносы ревью на вернувшихся пользователей, пока у каждого из них
+// не наберется средняя по активным участникам команды нагрузка или не кончится свободная емкость
+// (remaining, пользователи без лимита в нее не входят). В первую очередь ревью забираются
+// у неактивных ревьюверов, затем у самых загруженных.
+func planRebalance(assignments []*entity.ReviewAssignment, activeIds map[string]struct{}, targetIds []string, remaining map[string]int) []*entity.ReviewerMove {
+	moves := make([]*entity.ReviewerMove, 0)
+	if len(activeIds) == 0 || len(assignments) == 0 {
+		return moves
+	}
+
+	fairShare := len(assignments) / len(activeIds)
+
+	byReviewer := make(map[string][]*entity.ReviewAssignment)
+	reviewersByPr := make(map[string]map[string]struct{})
+	for _, a := range assignments {
+		byReviewer[a.ReviewerId] = append(byReviewer[a.ReviewerId], a)
+		if reviewersByPr[a.PullRequestId] == nil {
+			reviewersByPr[a.PullRequestId] = make(map[string]struct{})
+		}
+		reviewersByPr[a.PullRequestId][a.ReviewerId] = struct{}{}
+	}
+
+	for _, targetId := range targetIds {
+		exhausted := make(map[string]struct{})
+		free, limited := remaining[targetId]
+		for len(byReviewer[targetId]) < fairShare && (!limited || free > 0) {
+			donorId := pickDonor(byReviewer, activeIds, exhausted, targetId, fairShare)
+			if donorId == "" {
+				break
+			}
+
+			idx := -1
+			for i, a := range byReviewer[donorId] {
+				if _, assigned := reviewersByPr[a.PullRequestId][targetId]; a.AuthorId != targetId && !assigned {
+					idx = i
+					break
+				}
+			}
+			if idx < 0 {
+				exhausted[donorId] = struct{}{}
+				continue
+			}
+
+			a := byReviewer[donorId][idx]
+			byReviewer[donorId] = append(byReviewer[donorId][:idx], byReviewer[donorId][idx+1:]...)
+			delete(reviewersByPr[a.PullRequestId], donorId)
+			reviewersByPr[a.PullRequestId][targetId] = struct{}{}
+
+			moved := &entity.ReviewAssignment{PullRequestId: a.PullRequestId, AuthorId: a.AuthorId, ReviewerId: targetId}
+			byReviewer[targetId] = append(byReviewer[targetId], moved)
+
+			moves = append(moves, &entity.ReviewerMove{
+				PullRequestId:  a.PullRequestId,
+				FromReviewerId: donorId,
+				ToReviewerId:   targetId,
+			})
+			free--
+		}
+	}
+
+	return moves
+}
+
+// pickDonor возвращает ревьювера, у которого стоит забрать ревью: неактивного, если такой есть,
+// иначе самого загруженного из тех, чья нагрузка выше средней. Пустая строка - забирать не у кого.
+func pickDonor(byReviewer map[string][]*entity.ReviewAssignment, activeIds, exhausted map[string]struct{}, targetId string, fairShare int) string {
+	donorId := ""
+	donorLoad := 0
+	donorInactive := false
+	for reviewerId, reviews := range byReviewer {
+		if reviewerId == targetId || len(reviews) == 0 {
+			continue
+		}
+		if _, ok := exhausted[reviewerId]; ok {
+			continue
+		}
+		_, isActive := activeIds[reviewerId]
+		if isActive && len(reviews) <= fairShare {
+			continue
+		}
+		inactive := !isActive
+
+		better := false
+		switch {
+		case donorId == "":
+			better = true
+		case inactive != donorInactive:
+			better = inactive
+		case len(reviews) != donorLoad:
+			better = len(reviews) > donorLoad
+		default:
+			better = reviewerId < donorId
+		}
+		if better {
+			donorId, donorLoad, donorInactive = reviewerId, len(reviews), inactive
+		}
+	}
+	return donorId
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+const dataCsvDir = "../../../data_csv"
+
+type seededData struct {
+	users         [][]string
+	pullRequests  map[string][]string
+	prReviewers   [][]string
+	teamByUser    map[string]string
+	activeByUser  map[string]bool
+	membersByTeam map[string][]string
+}
+
+func readCsv(b *testing.B, name string) [][]string {
+	f, err := os.Open(filepath.Join(dataCsvDir, name))
+	require.NoError(b, err)
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	require.NoError(b, err)
+	return records[1:]
+}
+
+func loadSeededData(b *testing.B) *seededData {
+	data := &seededData{
+		users:         readCsv(b, "users.csv"),
+		pullRequests:  make(map[string][]string),
+		prReviewers:   readCsv(b, "pull_request_reviewers.csv"),
+		teamByUser:    make(map[string]string),
+		activeByUser:  make(map[string]bool),
+		membersByTeam: make(map[string][]string),
+	}
+	for _, u := range data.users {
+		data.teamByUser[u[0]] = u[2]
+		data.activeByUser[u[0]] = u[3] == "TRUE"
+		data.membersByTeam[u[2]] = append(data.membersByTeam[u[2]], u[0])
+	}
+	for _, pr := range readCsv(b, "pull_requests.csv") {
+		data.pullRequests[pr[0]] = pr
+	}
+	return data
+}
+
+// fixtures воспроизводит ответы GetOpenReviewAssignmentsByReviewers и GetReviewCandidatesByTeams
+// для деактивации половины участников каждой из команд teams.
+func (d *seededData) fixtures(teams []string) ([]*entity.ReviewAssignment, []*entity.ReviewCandidate, []string) {
+	deactivated := make(map[string]struct{})
+	deactivatedIds := make([]string, 0)
+	teamSet := make(map[string]struct{})
+	for _, team := range teams {
+		teamSet[team] = struct{}{}
+		members := d.membersByTeam[team]
+		for _, id := range members[:len(members)/2] {
+			deactivated[id] = struct{}{}
+			deactivatedIds = append(deactivatedIds, id)
+		}
+	}
+
+	affected := make(map[string]struct{})
+	openLoad := make(map[string]int)
+	for _, r := range d.prReviewers {
+		pr := d.pullRequests[r[0]]
+		if pr == nil || pr[3] != entity.StatusOpen.String() {
+			continue
+		}
+		openLoad[r[1]]++
+		if _, ok := deactivated[r[1]]; ok {
+			affected[r[0]] = struct{}{}
+		}
+	}
+
+	assignments := make([]*entity.ReviewAssignment, 0)
+	for _, r := range d.prReviewers {
+		if _, ok := affected[r[0]]; !ok {
+			continue
+		}
+		author := d.pullRequests[r[0]][2]
+		assignments = append(assignments, &entity.ReviewAssignment{
+			PullRequestId: r[0],
+			AuthorId:      author,
+			ReviewerId:    r[1],
+			TeamName:      d.teamByUser[author],
+		})
+	}
+
+	candidates := make([]*entity.ReviewCandidate, 0)
+	for _, u := range d.users {
+		if _, ok := deactivated[u[0]]; ok || !d.activeByUser[u[0]] {
+			continue
+		}
+		if _, ok := teamSet[u[2]]; !ok {
+			continue
+		}
+		candidates = append(candidates, &entity.ReviewCandidate{UserId: u[0], TeamName: u[2], OpenReviews: openLoad[u[0]]})
+	}
+
+	return assignments, candidates, deactivatedIds
+}
+
+func benchmarkPlanDeactivation(b *testing.B, teams func(d *seededData) []string) {
+	data := loadSeededData(b)
+	assignments, candidates, deactivatedIds := data.fixtures(teams(data))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// planDeactivation меняет нагрузку кандидатов, поэтому на каждой итерации нужна свежая копия
+		pool := make([]*entity.ReviewCandidate, len(candidates))
+		for j, c := range candidates {
+			copied := *c
+			pool[j] = &copied
+		}
+		planDeactivation(assignments, pool, deactivatedIds)
+	}
+}
+
+func BenchmarkPlanDeactivation_SingleTeam(b *testing.B) {
+	benchmarkPlanDeactivation(b, func(d *seededData) []string {
+		return []string{"test_backend"}
+	})
+}
+
+func BenchmarkPlanDeactivation_AllTeams(b *testing.B) {
+	benchmarkPlanDeactivation(b, func(d *seededData) []string {
+		teams := make([]string, 0, len(d.membersByTeam))
+		for team := range d.membersByTeam {
+			teams = append(teams, team)
+		}
+		return teams
+	})
+}
@@ -2,9 +2,12 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/outbox"
 	pullrequest "github.com/Mockird31/avito_tech/internal/pullRequest"
+	"github.com/Mockird31/avito_tech/internal/team"
 	"github.com/Mockird31/avito_tech/internal/user"
 	"go.uber.org/zap"
 
@@ -14,15 +17,215 @@ import (
 type usecase struct {
 	UserRepository user.IRepository
 	PRRepository   pullrequest.IRepository
+	TeamRepository team.IRepository
+	Outbox         outbox.IRepository
+	Transactor     outbox.ITransactor
 }
 
-func NewUsecase(userRepository user.IRepository, PRRepository pullrequest.IRepository) user.IUsecase {
+func NewUsecase(userRepository user.IRepository, PRRepository pullrequest.IRepository, TeamRepository team.IRepository, Outbox outbox.IRepository, Transactor outbox.ITransactor) user.IUsecase {
 	return &usecase{
 		UserRepository: userRepository,
 		PRRepository:   PRRepository,
+		TeamRepository: TeamRepository,
+		Outbox:         Outbox,
+		Transactor:     Transactor,
 	}
 }
 
+// publishMoves записывает в outbox событие о каждом перенесенном ревью, вызывается внутри WithinTx.
+func (u *usecase) publishMoves(ctx context.Context, moves []*entity.ReviewerMove) error {
+	occurredAt := time.Now().UTC()
+	events := make([]*entity.DomainEvent, 0, len(moves))
+	for _, move := range moves {
+		events = append(events, &entity.DomainEvent{
+			Type:          entity.EventTypeReviewerReassigned,
+			PullRequestId: move.PullRequestId,
+			ReviewerId:    move.ToReviewerId,
+			OldReviewerId: move.FromReviewerId,
+			OccurredAt:    occurredAt,
+		})
+	}
+	return u.Outbox.AddEvents(ctx, events)
+}
+
+func (u *usecase) CreateUser(ctx context.Context, userCreate *entity.UserCreate) (*entity.User, error) {
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, userCreate.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if isExist {
+		return nil, entity.ErrUserExist
+	}
+
+	if err := u.checkTeamExist(ctx, userCreate.TeamName); err != nil {
+		return nil, err
+	}
+
+	err = u.UserRepository.CreateUser(ctx, userCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.User{
+		UserId:   userCreate.UserId,
+		Username: userCreate.Username,
+		TeamName: userCreate.TeamName,
+		IsActive: userCreate.IsActive,
+	}, nil
+}
+
+func (u *usecase) UpdateUser(ctx context.Context, userUpdate *entity.UserUpdate) (*entity.User, error) {
+	if userUpdate.Username == nil && userUpdate.TeamName == nil {
+		return nil, entity.ErrNothingToUpdate
+	}
+
+	if (userUpdate.Username != nil && *userUpdate.Username == "") || (userUpdate.TeamName != nil && *userUpdate.TeamName == "") {
+		return nil, entity.ErrNothingToUpdate
+	}
+
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, userUpdate.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		return nil, entity.ErrUserNotFound
+	}
+
+	if userUpdate.TeamName != nil {
+		if err := u.checkTeamExist(ctx, *userUpdate.TeamName); err != nil {
+			return nil, err
+		}
+	}
+
+	err = u.UserRepository.UpdateUser(ctx, userUpdate.UserId, userUpdate.Username, userUpdate.TeamName)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.UserRepository.GetUserById(ctx, userUpdate.UserId)
+}
+
+// PatchUser меняет имя, команду и активность пользователя в одной транзакции: при ошибке
+// любой части не применяется ничего.
+func (u *usecase) PatchUser(ctx context.Context, userPatch *entity.UserPatch) (*entity.User, error) {
+	if userPatch.Username == nil && userPatch.TeamName == nil && userPatch.IsActive == nil {
+		return nil, entity.ErrNothingToUpdate
+	}
+
+	var user *entity.User
+	err := u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if userPatch.Username != nil || userPatch.TeamName != nil {
+			user, err = u.UpdateUser(ctx, &entity.UserUpdate{UserId: userPatch.UserId, Username: userPatch.Username, TeamName: userPatch.TeamName})
+			if err != nil {
+				return err
+			}
+		}
+		if userPatch.IsActive != nil {
+			user, err = u.SetIsActive(ctx, &entity.UserUpdateActive{UserId: userPatch.UserId, IsActive: *userPatch.IsActive})
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (u *usecase) DeleteUser(ctx context.Context, userDelete *entity.UserDelete) (*entity.UserDeleteResult, error) {
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, userDelete.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		return nil, entity.ErrUserNotFound
+	}
+
+	user, err := u.UserRepository.GetUserById(ctx, userDelete.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	// открытые ревью переназначаются так же, как при деактивации, в той же транзакции, что и удаление
+	var deactivated *entity.DeactivateUsers
+	err = u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		deactivated, err = u.DeactivateTeamUsers(ctx, &entity.DeactivateUsers{
+			TeamName: user.TeamName,
+			UserIds:  []string{user.UserId},
+		})
+		if err != nil {
+			return err
+		}
+
+		return u.UserRepository.SoftDeleteUser(ctx, user.UserId, entity.DeletedUsername)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.UserDeleteResult{
+		UserId:        user.UserId,
+		Reassignments: deactivated.Reassignments,
+		Summary:       deactivated.Summary,
+	}, nil
+}
+
+func (u *usecase) GetUser(ctx context.Context, userId string) (*entity.User, error) {
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		return nil, entity.ErrUserNotFound
+	}
+
+	return u.UserRepository.GetUserById(ctx, userId)
+}
+
+func (u *usecase) ListUsers(ctx context.Context, filter *entity.UserListFilter) (*entity.UserList, error) {
+	if filter.Limit < 0 || filter.Limit > entity.MaxPageLimit || filter.Offset < 0 {
+		return nil, entity.ErrInvalidPagination
+	}
+
+	if filter.Limit == 0 {
+		filter.Limit = entity.DefaultPageLimit
+	}
+
+	users, err := u.UserRepository.ListUsers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := u.UserRepository.CountUsers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.UserList{
+		Users:  users,
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}, nil
+}
+
+func (u *usecase) checkTeamExist(ctx context.Context, teamName string) error {
+	isExist, err := u.TeamRepository.CheckTeamNameExist(ctx, teamName)
+	if err != nil {
+		return err
+	}
+
+	if !isExist {
+		return entity.ErrTeamNameNotFound
+	}
+	return nil
+}
+
 func (u *usecase) SetIsActive(ctx context.Context, userUpdateActive *entity.UserUpdateActive) (*entity.User, error) {
 	isExist, err := u.UserRepository.CheckUserExistById(ctx, userUpdateActive.UserId)
 	if err != nil {
@@ -45,81 +248,345 @@ func (u *usecase) SetIsActive(ctx context.Context, userUpdateActive *entity.User
 	return user, nil
 }
 
-func (u *usecase) GetUserReview(ctx context.Context, userId string) ([]*entity.PullRequestShort, string, error) {
-	logger := loggerPkg.LoggerFromContext(ctx)
+func (u *usecase) SetReviewCapacity(ctx context.Context, capacity *entity.UserReviewCapacity) (*entity.UserReviewCapacity, error) {
+	if capacity.MaxOpenReviews != nil && *capacity.MaxOpenReviews < 1 {
+		return nil, entity.ErrInvalidReviewCapacity
+	}
 
-	isExist, err := u.UserRepository.CheckUserExistById(ctx, userId)
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, capacity.UserId)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	if !isExist {
-		logger.Error("user not exist", zap.Error(err), zap.String("user_id", userId))
-		return nil, "", entity.ErrUserNotFound
+		return nil, entity.ErrUserNotFound
 	}
 
-	pullRequests, err := u.PRRepository.GetPullRequestsByReviewerId(ctx, userId)
+	err = u.UserRepository.SetReviewCapacity(ctx, capacity.UserId, capacity.MaxOpenReviews)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
-	return pullRequests, userId, nil
+	return capacity, nil
 }
 
-func (u *usecase) DeactivateTeamUsers(ctx context.Context, deactivateUsers *entity.DeactivateUsers) (*entity.DeactivateUsers, error) {
+func (u *usecase) GetUserReview(ctx context.Context, filter *entity.PullRequestFilter) (*entity.ReviewerPullRequests, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 
-	if len(deactivateUsers.UserIds) == 0 {
-		return &entity.DeactivateUsers{TeamName: deactivateUsers.TeamName, UserIds: []string{}}, nil
+	if err := filter.Normalize(); err != nil {
+		return nil, err
+	}
+
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, filter.ReviewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
+		logger.Error("user not exist", zap.Error(err), zap.String("user_id", filter.ReviewerId))
+		return nil, entity.ErrUserNotFound
+	}
+
+	// одна лишняя строка показывает, есть ли следующая страница
+	page := *filter
+	page.Limit = filter.Limit + 1
+
+	pullRequests, err := u.PRRepository.GetPullRequestsByReviewerId(ctx, &page)
+	if err != nil {
+		return nil, err
 	}
-	usersMap, err := u.UserRepository.GetUsersByIds(ctx, deactivateUsers.UserIds)
+
+	total, err := u.PRRepository.CountPullRequestsByReviewerId(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(usersMap) != len(deactivateUsers.UserIds) {
+	pullRequests, nextCursor := entity.PagePullRequests(pullRequests, filter.Limit)
+
+	return &entity.ReviewerPullRequests{
+		UserId:       filter.ReviewerId,
+		PullRequests: pullRequests,
+		Total:        total,
+		NextCursor:   nextCursor,
+	}, nil
+}
+
+func (u *usecase) GetUserAuthored(ctx context.Context, filter *entity.PullRequestFilter) (*entity.AuthorPullRequests, error) {
+	if err := filter.Normalize(); err != nil {
+		return nil, err
+	}
+
+	isExist, err := u.UserRepository.CheckUserExistById(ctx, filter.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isExist {
 		return nil, entity.ErrUserNotFound
 	}
 
-	for _, id := range deactivateUsers.UserIds {
+	page := *filter
+	page.Limit = filter.Limit + 1
+
+	pullRequests, err := u.PRRepository.GetPullRequestsByAuthorId(ctx, &page)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := u.PRRepository.CountPullRequestsByAuthorId(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequests, nextCursor := entity.PagePullRequests(pullRequests, filter.Limit)
+
+	prIds := make([]string, 0, len(pullRequests))
+	for _, pr := range pullRequests {
+		prIds = append(prIds, pr.Id)
+	}
+
+	reviewersByPr, err := u.PRRepository.GetReviewersByPrIds(ctx, prIds)
+	if err != nil {
+		return nil, err
+	}
+
+	authored := make([]*entity.AuthoredPullRequest, 0, len(pullRequests))
+	for _, pr := range pullRequests {
+		reviewers := reviewersByPr[pr.Id]
+		if reviewers == nil {
+			reviewers = []*entity.PullRequestReviewer{}
+		}
+		for _, reviewer := range reviewers {
+			reviewer.State = reviewState(pr, reviewer)
+		}
+		authored = append(authored, &entity.AuthoredPullRequest{PullRequestShort: pr, Reviewers: reviewers})
+	}
+
+	return &entity.AuthorPullRequests{
+		UserId:       filter.AuthorId,
+		PullRequests: authored,
+		Total:        total,
+		NextCursor:   nextCursor,
+	}, nil
+}
+
+func reviewState(pr *entity.PullRequestShort, reviewer *entity.PullRequestReviewer) string {
+	switch {
+	case pr.Status == entity.StatusMerged.String(), pr.Status == entity.StatusClosed.String():
+		return entity.ReviewStateCompleted
+	case !reviewer.IsActive:
+		return entity.ReviewStateReviewerInactive
+	default:
+		return entity.ReviewStatePending
+	}
+}
+
+func (u *usecase) DeactivateTeamUsers(ctx context.Context, deactivateUsers *entity.DeactivateUsers) (*entity.DeactivateUsers, error) {
+	if len(deactivateUsers.UserIds) == 0 {
+		return &entity.DeactivateUsers{
+			TeamName:      deactivateUsers.TeamName,
+			UserIds:       []string{},
+			DryRun:        deactivateUsers.DryRun,
+			Reassignments: []*entity.ReviewerReassignment{},
+			Summary:       &entity.DeactivationSummary{},
+		}, nil
+	}
+	if err := u.checkTeamUsers(ctx, deactivateUsers.TeamName, deactivateUsers.UserIds); err != nil {
+		return nil, err
+	}
+
+	if deactivateUsers.DryRun {
+		report, _, err := u.prepareDeactivation(ctx, deactivateUsers)
+		return report, err
+	}
+
+	// план строится внутри транзакции: затронутые pull request'ы заблокированы до коммита,
+	// поэтому параллельные переназначения, merge и ручные изменения ревьюверов не расходятся с планом
+	var report *entity.DeactivateUsers
+	err := u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var moves []*entity.ReviewerMove
+		var err error
+		report, moves, err = u.prepareDeactivation(ctx, deactivateUsers)
+		if err != nil {
+			return err
+		}
+
+		if err := u.PRRepository.UpdateReviewersBatch(ctx, moves); err != nil {
+			return err
+		}
+
+		if err := u.UserRepository.UpdateUsersIsActiveByIds(ctx, deactivateUsers.UserIds, false); err != nil {
+			return err
+		}
+		return u.publishMoves(ctx, moves)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// prepareDeactivation загружает разом все затронутые открытые pull request'ы (блокируя их, если вызвана
+// в транзакции) и пулы кандидатов, а замены рассчитывает в памяти для применения одним запросом.
+func (u *usecase) prepareDeactivation(ctx context.Context, deactivateUsers *entity.DeactivateUsers) (*entity.DeactivateUsers, []*entity.ReviewerMove, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	assignments, err := u.PRRepository.GetOpenReviewAssignmentsByReviewers(ctx, deactivateUsers.UserIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reassignments := make([]*entity.ReviewerReassignment, 0)
+	if len(assignments) > 0 {
+		teamNames := make([]string, 0)
+		seenTeams := make(map[string]struct{})
+		for _, a := range assignments {
+			if _, ok := seenTeams[a.TeamName]; !ok {
+				seenTeams[a.TeamName] = struct{}{}
+				teamNames = append(teamNames, a.TeamName)
+			}
+		}
+
+		candidates, err := u.UserRepository.GetReviewCandidatesByTeams(ctx, teamNames, deactivateUsers.UserIds)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		reassignments = planDeactivation(assignments, candidates, deactivateUsers.UserIds)
+	}
+
+	moves := make([]*entity.ReviewerMove, 0, len(reassignments))
+	for _, r := range reassignments {
+		if r.NewReviewerId == nil {
+			logger.Info("no available reviewer (DeactivateTeamUsers)", zap.String("pr_id", r.PullRequestId), zap.String("old_reviewer_id", r.OldReviewerId), zap.String("reason", r.Reason))
+			continue
+		}
+		moves = append(moves, &entity.ReviewerMove{
+			PullRequestId:  r.PullRequestId,
+			FromReviewerId: r.OldReviewerId,
+			ToReviewerId:   *r.NewReviewerId,
+		})
+	}
+
+	report := &entity.DeactivateUsers{
+		TeamName:      deactivateUsers.TeamName,
+		UserIds:       deactivateUsers.UserIds,
+		DryRun:        deactivateUsers.DryRun,
+		Reassignments: reassignments,
+		Summary:       summarizeReassignments(reassignments),
+	}
+	return report, moves, nil
+}
+
+func summarizeReassignments(reassignments []*entity.ReviewerReassignment) *entity.DeactivationSummary {
+	summary := &entity.DeactivationSummary{}
+	affected := make(map[string]struct{})
+	for _, r := range reassignments {
+		affected[r.PullRequestId] = struct{}{}
+		if r.NewReviewerId != nil {
+			summary.Reassigned++
+		} else {
+			summary.WithoutReplacement++
+		}
+	}
+	summary.AffectedPullRequests = len(affected)
+	return summary
+}
+
+func (u *usecase) checkTeamUsers(ctx context.Context, teamName string, userIds []string) error {
+	usersMap, err := u.UserRepository.GetUsersByIds(ctx, userIds)
+	if err != nil {
+		return err
+	}
+
+	if len(usersMap) != len(userIds) {
+		return entity.ErrUserNotFound
+	}
+
+	for _, id := range userIds {
 		uinfo := usersMap[id]
-		if uinfo == nil || uinfo.TeamName != deactivateUsers.TeamName {
-			return nil, entity.ErrUsersNotSameTeam
+		if uinfo == nil || uinfo.TeamName != teamName {
+			return entity.ErrUsersNotSameTeam
 		}
 	}
+	return nil
+}
+
+func (u *usecase) ReactivateTeamUsers(ctx context.Context, reactivateUsers *entity.ReactivateUsers) (*entity.ReactivateUsersResult, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	result := &entity.ReactivateUsersResult{
+		TeamName: reactivateUsers.TeamName,
+		UserIds:  reactivateUsers.UserIds,
+		DryRun:   reactivateUsers.DryRun,
+		Moves:    []*entity.ReviewerMove{},
+	}
+
+	if len(reactivateUsers.UserIds) == 0 {
+		result.UserIds = []string{}
+		return result, nil
+	}
+
+	if err := u.checkTeamUsers(ctx, reactivateUsers.TeamName, reactivateUsers.UserIds); err != nil {
+		return nil, err
+	}
 
-	exclude := make([]string, 0, len(deactivateUsers.UserIds))
-	exclude = append(exclude, deactivateUsers.UserIds...)
+	if reactivateUsers.Rebalance {
+		members, err := u.UserRepository.GetMembersByTeamName(ctx, reactivateUsers.TeamName)
+		if err != nil {
+			return nil, err
+		}
 
-	for _, reviewerID := range deactivateUsers.UserIds {
-		prs, err := u.PRRepository.GetPullRequestsByReviewerId(ctx, reviewerID)
+		assignments, err := u.PRRepository.GetOpenReviewAssignmentsByTeam(ctx, reactivateUsers.TeamName)
 		if err != nil {
 			return nil, err
 		}
-		for _, pr := range prs {
-			if pr.Status != "OPEN" {
-				continue
-			}
-			newReviewerID, err := u.UserRepository.FindNewReviewerExcluding(ctx, pr.Id, pr.AuthorId, exclude)
-			if err != nil {
-				return nil, err
+
+		activeIds := make(map[string]struct{}, len(members))
+		for _, member := range members {
+			if member.IsActive {
+				activeIds[member.UserID] = struct{}{}
 			}
-			if newReviewerID != "" {
-				if err := u.PRRepository.UpdateReviewerId(ctx, pr.Id, reviewerID, newReviewerID); err != nil {
-					return nil, err
-				}
-			} else {
-				logger.Info("no available reviewer (DeactivateTeamUsersWithList)", zap.String("pr_id", pr.Id), zap.String("old_reviewer_id", reviewerID))
+		}
+		for _, id := range reactivateUsers.UserIds {
+			activeIds[id] = struct{}{}
+		}
+
+		loads, err := u.UserRepository.GetReviewLoadsByIds(ctx, reactivateUsers.UserIds)
+		if err != nil {
+			return nil, err
+		}
+		remaining := make(map[string]int, len(loads))
+		for _, load := range loads {
+			if load.MaxOpenReviews != nil {
+				remaining[load.UserId] = max(*load.MaxOpenReviews-load.OpenReviews, 0)
 			}
 		}
+
+		result.Moves = planRebalance(assignments, activeIds, reactivateUsers.UserIds, remaining)
 	}
 
-	if err := u.UserRepository.UpdateUsersIsActiveByIds(ctx, deactivateUsers.UserIds, false); err != nil {
+	if reactivateUsers.DryRun {
+		return result, nil
+	}
+
+	err := u.Transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := u.UserRepository.UpdateUsersIsActiveByIds(ctx, reactivateUsers.UserIds, true); err != nil {
+			return err
+		}
+
+		for _, move := range result.Moves {
+			if err := u.PRRepository.UpdateReviewerId(ctx, move.PullRequestId, move.FromReviewerId, move.ToReviewerId); err != nil {
+				return err
+			}
+			logger.Info("review moved to reactivated user (ReactivateTeamUsers)", zap.String("pr_id", move.PullRequestId), zap.String("from_reviewer_id", move.FromReviewerId), zap.String("to_reviewer_id", move.ToReviewerId))
+		}
+		return u.publishMoves(ctx, result.Moves)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return &entity.DeactivateUsers{
-		TeamName: deactivateUsers.TeamName,
-		UserIds:  deactivateUsers.UserIds,
-	}, nil
+	return result, nil
 }
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Mockird31/avito_tech/internal/entity"
 	"github.com/Mockird31/avito_tech/internal/user"
+	mock_outbox "github.com/Mockird31/avito_tech/mocks/outbox"
 	mock_pullrequest "github.com/Mockird31/avito_tech/mocks/pullrequest"
+	mock_team "github.com/Mockird31/avito_tech/mocks/team"
 	mock_user "github.com/Mockird31/avito_tech/mocks/user"
 	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
 	"github.com/stretchr/testify/assert"
@@ -17,11 +20,26 @@ import (
 )
 
 func setupTest(t *testing.T) (user.IUsecase, *mock_user.MockIRepository, *mock_pullrequest.MockIRepository) {
+	userUsecase, userRepo, prRepo, _ := setupTestWithTeam(t)
+	return userUsecase, userRepo, prRepo
+}
+
+func setupTestWithTeam(t *testing.T) (user.IUsecase, *mock_user.MockIRepository, *mock_pullrequest.MockIRepository, *mock_team.MockIRepository) {
 	userRepo := mock_user.NewMockIRepository(t)
 	prRepo := mock_pullrequest.NewMockIRepository(t)
-
-	userUsecase := NewUsecase(userRepo, prRepo)
-	return userUsecase, userRepo, prRepo
+	teamRepo := mock_team.NewMockIRepository(t)
+	outboxRepo := mock_outbox.NewMockIRepository(t)
+	outboxRepo.EXPECT().AddEvents(mock.Anything, mock.Anything).Return(nil).Maybe()
+	transactor := mock_outbox.NewMockITransactor(t)
+	transactor.EXPECT().
+		WithinTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+
+	userUsecase := NewUsecase(userRepo, prRepo, teamRepo, outboxRepo, transactor)
+	return userUsecase, userRepo, prRepo, teamRepo
 }
 
 func getTestContext() context.Context {
@@ -99,7 +117,7 @@ func TestGetUserReview_Success(t *testing.T) {
 	uc, userRepo, prRepo := setupTest(t)
 
 	userId := "u1"
-	want := []*entity.PullRequestShort{
+	prs := []*entity.PullRequestShort{
 		{Id: "pr1", PrName: "PR 1"},
 		{Id: "pr2", PrName: "PR 2"},
 	}
@@ -108,13 +126,58 @@ func TestGetUserReview_Success(t *testing.T) {
 		CheckUserExistById(mock.Anything, userId).
 		Return(true, nil)
 	prRepo.EXPECT().
-		GetPullRequestsByReviewerId(mock.Anything, userId).
-		Return(want, nil)
+		GetPullRequestsByReviewerId(mock.Anything, &entity.PullRequestFilter{ReviewerId: userId, Order: entity.SortOrderDesc, Limit: entity.DefaultPageLimit + 1}).
+		Return(prs, nil)
+	prRepo.EXPECT().
+		CountPullRequestsByReviewerId(mock.Anything, &entity.PullRequestFilter{ReviewerId: userId, Order: entity.SortOrderDesc, Limit: entity.DefaultPageLimit}).
+		Return(2, nil)
+
+	got, err := uc.GetUserReview(ctx, &entity.PullRequestFilter{ReviewerId: userId})
+	require.NoError(t, err)
+	assert.Equal(t, &entity.ReviewerPullRequests{UserId: userId, PullRequests: prs, Total: 2}, got)
+}
+
+func TestGetUserReview_NextCursor(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
 
-	got, id, err := uc.GetUserReview(ctx, userId)
+	userId := "u1"
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	prs := []*entity.PullRequestShort{
+		{Id: "pr3", CreatedAt: createdAt.Add(2 * time.Hour)},
+		{Id: "pr2", CreatedAt: createdAt.Add(time.Hour)},
+		{Id: "pr1", CreatedAt: createdAt},
+	}
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, userId).
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetPullRequestsByReviewerId(mock.Anything, mock.MatchedBy(func(f *entity.PullRequestFilter) bool { return f.Limit == 3 })).
+		Return(prs, nil)
+	prRepo.EXPECT().
+		CountPullRequestsByReviewerId(mock.Anything, mock.Anything).
+		Return(5, nil)
+
+	got, err := uc.GetUserReview(ctx, &entity.PullRequestFilter{ReviewerId: userId, Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, prs[:2], got.PullRequests)
+	assert.Equal(t, 5, got.Total)
+
+	cursor, err := entity.DecodePullRequestCursor(got.NextCursor)
 	require.NoError(t, err)
-	assert.Equal(t, userId, id)
-	assert.Equal(t, want, got)
+	assert.Equal(t, "pr2", cursor.Id)
+	assert.True(t, cursor.CreatedAt.Equal(createdAt.Add(time.Hour)))
+}
+
+func TestGetUserReview_InvalidFilter(t *testing.T) {
+	ctx := getTestContext()
+	uc, _, _ := setupTest(t)
+
+	got, err := uc.GetUserReview(ctx, &entity.PullRequestFilter{ReviewerId: "u1", Status: "DRAFT"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrInvalidFilter)
 }
 
 func TestGetUserReview_CheckExist_DBError(t *testing.T) {
@@ -128,10 +191,9 @@ func TestGetUserReview_CheckExist_DBError(t *testing.T) {
 		CheckUserExistById(mock.Anything, userId).
 		Return(false, dbErr)
 
-	got, id, err := uc.GetUserReview(ctx, userId)
+	got, err := uc.GetUserReview(ctx, &entity.PullRequestFilter{ReviewerId: userId})
 	require.Error(t, err)
 	assert.Nil(t, got)
-	assert.Empty(t, id)
 	assert.EqualError(t, err, dbErr.Error())
 
 	prRepo.AssertNotCalled(t, "GetPullRequestsByReviewerId", mock.Anything, mock.Anything)
@@ -147,10 +209,9 @@ func TestGetUserReview_UserNotExist(t *testing.T) {
 		CheckUserExistById(mock.Anything, userId).
 		Return(false, nil)
 
-	got, id, err := uc.GetUserReview(ctx, userId)
+	got, err := uc.GetUserReview(ctx, &entity.PullRequestFilter{ReviewerId: userId})
 	require.Error(t, err)
 	assert.Nil(t, got)
-	assert.Empty(t, id)
 	assert.ErrorIs(t, err, entity.ErrUserNotFound)
 
 	prRepo.AssertNotCalled(t, "GetPullRequestsByReviewerId", mock.Anything, mock.Anything)
@@ -167,13 +228,12 @@ func TestGetUserReview_PRRepoError(t *testing.T) {
 		CheckUserExistById(mock.Anything, userId).
 		Return(true, nil)
 	prRepo.EXPECT().
-		GetPullRequestsByReviewerId(mock.Anything, userId).
+		GetPullRequestsByReviewerId(mock.Anything, mock.Anything).
 		Return(nil, dbErr)
 
-	got, id, err := uc.GetUserReview(ctx, userId)
+	got, err := uc.GetUserReview(ctx, &entity.PullRequestFilter{ReviewerId: userId})
 	require.Error(t, err)
 	assert.Nil(t, got)
-	assert.Empty(t, id)
 	assert.EqualError(t, err, dbErr.Error())
 }
 
@@ -185,9 +245,14 @@ func TestDeactivateTeamUsers_EmptyList(t *testing.T) {
 
 	res, err := uc.DeactivateTeamUsers(ctx, req)
 	require.NoError(t, err)
-	assert.Equal(t, &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{}}, res)
-
-	prRepo.AssertNotCalled(t, "GetPullRequestsByReviewerId", mock.Anything, mock.Anything)
+	assert.Equal(t, &entity.DeactivateUsers{
+		TeamName:      "teamA",
+		UserIds:       []string{},
+		Reassignments: []*entity.ReviewerReassignment{},
+		Summary:       &entity.DeactivationSummary{},
+	}, res)
+
+	prRepo.AssertNotCalled(t, "GetOpenReviewAssignmentsByReviewers", mock.Anything, mock.Anything)
 	userRepo.AssertNotCalled(t, "GetUsersByIds", mock.Anything, mock.Anything)
 }
 
@@ -207,7 +272,7 @@ func TestDeactivateTeamUsers_GetUsersByIds_Error(t *testing.T) {
 	assert.Nil(t, res)
 	assert.EqualError(t, err, dbErr.Error())
 
-	prRepo.AssertNotCalled(t, "GetPullRequestsByReviewerId", mock.Anything, mock.Anything)
+	prRepo.AssertNotCalled(t, "GetOpenReviewAssignmentsByReviewers", mock.Anything, mock.Anything)
 	userRepo.AssertNotCalled(t, "UpdateUsersIsActiveByIds", mock.Anything, mock.Anything, mock.Anything)
 }
 
@@ -228,7 +293,7 @@ func TestDeactivateTeamUsers_UserNotFound(t *testing.T) {
 	assert.Nil(t, res)
 	assert.ErrorIs(t, err, entity.ErrUserNotFound)
 
-	prRepo.AssertNotCalled(t, "GetPullRequestsByReviewerId", mock.Anything, mock.Anything)
+	prRepo.AssertNotCalled(t, "GetOpenReviewAssignmentsByReviewers", mock.Anything, mock.Anything)
 	userRepo.AssertNotCalled(t, "UpdateUsersIsActiveByIds", mock.Anything, mock.Anything, mock.Anything)
 }
 
@@ -250,16 +315,11 @@ func TestDeactivateTeamUsers_UsersNotSameTeam(t *testing.T) {
 	assert.Nil(t, res)
 	assert.ErrorIs(t, err, entity.ErrUsersNotSameTeam)
 
-	prRepo.AssertNotCalled(t, "GetPullRequestsByReviewerId", mock.Anything, mock.Anything)
+	prRepo.AssertNotCalled(t, "GetOpenReviewAssignmentsByReviewers", mock.Anything, mock.Anything)
 	userRepo.AssertNotCalled(t, "UpdateUsersIsActiveByIds", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestDeactivateTeamUsers_Success_ReassignAndDeactivate(t *testing.T) {
-	ctx := getTestContext()
-	uc, userRepo, prRepo := setupTest(t)
-
-	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1", "u2"}}
-
+func deactivateFixture(userRepo *mock_user.MockIRepository, prRepo *mock_pullrequest.MockIRepository) {
 	userRepo.EXPECT().
 		GetUsersByIds(mock.Anything, []string{"u1", "u2"}).
 		Return(map[string]*entity.User{
@@ -268,38 +328,122 @@ func TestDeactivateTeamUsers_Success_ReassignAndDeactivate(t *testing.T) {
 		}, nil)
 
 	prRepo.EXPECT().
-		GetPullRequestsByReviewerId(mock.Anything, "u1").
-		Return([]*entity.PullRequestShort{
-			{Id: "pr1", PrName: "A", AuthorId: "a1", Status: "OPEN"},
-			{Id: "pr2", PrName: "B", AuthorId: "a2", Status: "MERGED"},
+		GetOpenReviewAssignmentsByReviewers(mock.Anything, []string{"u1", "u2"}).
+		Return([]*entity.ReviewAssignment{
+			{PullRequestId: "pr1", AuthorId: "a1", ReviewerId: "u1", TeamName: "teamA"},
+			{PullRequestId: "pr1", AuthorId: "a1", ReviewerId: "u3", TeamName: "teamA"},
+			{PullRequestId: "pr2", AuthorId: "u3", ReviewerId: "u1", TeamName: "teamA"},
+			{PullRequestId: "pr2", AuthorId: "u3", ReviewerId: "u2", TeamName: "teamA"},
 		}, nil)
 
+	limitU4, limitU5 := 4, 1
 	userRepo.EXPECT().
-		FindNewReviewerExcluding(mock.Anything, "pr1", "a1", []string{"u1", "u2"}).
-		Return("u3", nil)
+		GetReviewCandidatesByTeams(mock.Anything, []string{"teamA"}, []string{"u1", "u2"}).
+		Return([]*entity.ReviewCandidate{
+			{UserId: "a1", TeamName: "teamA", OpenReviews: 0},
+			{UserId: "u3", TeamName: "teamA", OpenReviews: 1},
+			{UserId: "u4", TeamName: "teamA", OpenReviews: 3, MaxOpenReviews: &limitU4},
+			{UserId: "u5", TeamName: "teamA", OpenReviews: 1, MaxOpenReviews: &limitU5},
+		}, nil)
+}
+
+func expectedDeactivationReport(dryRun bool) *entity.DeactivateUsers {
+	u4, a1 := "u4", "a1"
+	return &entity.DeactivateUsers{
+		TeamName: "teamA",
+		UserIds:  []string{"u1", "u2"},
+		DryRun:   dryRun,
+		Reassignments: []*entity.ReviewerReassignment{
+			{PullRequestId: "pr1", OldReviewerId: "u1", NewReviewerId: &u4, Result: entity.ReassignmentReassigned, Reason: entity.ReassignmentReasonReplaced},
+			{PullRequestId: "pr2", OldReviewerId: "u1", NewReviewerId: &a1, Result: entity.ReassignmentReassigned, Reason: entity.ReassignmentReasonReplaced},
+			{PullRequestId: "pr2", OldReviewerId: "u2", Result: entity.ReassignmentNoCandidate, Reason: entity.ReassignmentReasonAtCapacity},
+		},
+		Summary: &entity.DeactivationSummary{AffectedPullRequests: 2, Reassigned: 2, WithoutReplacement: 1},
+	}
+}
+
+func TestDeactivateTeamUsers_Success_ReassignAndDeactivate(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1", "u2"}}
+	deactivateFixture(userRepo, prRepo)
+
 	prRepo.EXPECT().
-		UpdateReviewerId(mock.Anything, "pr1", "u1", "u3").
+		UpdateReviewersBatch(mock.Anything, []*entity.ReviewerMove{
+			{PullRequestId: "pr1", FromReviewerId: "u1", ToReviewerId: "u4"},
+			{PullRequestId: "pr2", FromReviewerId: "u1", ToReviewerId: "a1"},
+		}).
+		Return(nil)
+	userRepo.EXPECT().
+		UpdateUsersIsActiveByIds(mock.Anything, []string{"u1", "u2"}, false).
 		Return(nil)
 
+	res, err := uc.DeactivateTeamUsers(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, expectedDeactivationReport(false), res)
+}
+
+func TestDeactivateTeamUsers_ReviewersChangedConcurrently(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1", "u2"}}
+	deactivateFixture(userRepo, prRepo)
+
 	prRepo.EXPECT().
-		GetPullRequestsByReviewerId(mock.Anything, "u2").
-		Return([]*entity.PullRequestShort{
-			{Id: "pr3", PrName: "C", AuthorId: "a3", Status: "OPEN"},
-		}, nil)
-	userRepo.EXPECT().
-		FindNewReviewerExcluding(mock.Anything, "pr3", "a3", []string{"u1", "u2"}).
-		Return("", nil)
+		UpdateReviewersBatch(mock.Anything, mock.Anything).
+		Return(entity.ErrReviewersChanged)
+
+	res, err := uc.DeactivateTeamUsers(ctx, req)
+	require.ErrorIs(t, err, entity.ErrReviewersChanged)
+	assert.Nil(t, res)
+
+	userRepo.AssertNotCalled(t, "UpdateUsersIsActiveByIds", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeactivateTeamUsers_DryRun(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1", "u2"}, DryRun: true}
+	deactivateFixture(userRepo, prRepo)
+
+	res, err := uc.DeactivateTeamUsers(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, expectedDeactivationReport(true), res)
+
+	prRepo.AssertNotCalled(t, "UpdateReviewersBatch", mock.Anything, mock.Anything)
+	userRepo.AssertNotCalled(t, "UpdateUsersIsActiveByIds", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeactivateTeamUsers_NoOpenReviews(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1"}}
 
 	userRepo.EXPECT().
-		UpdateUsersIsActiveByIds(mock.Anything, []string{"u1", "u2"}, false).
+		GetUsersByIds(mock.Anything, []string{"u1"}).
+		Return(map[string]*entity.User{"u1": {UserId: "u1", TeamName: "teamA"}}, nil)
+	prRepo.EXPECT().
+		GetOpenReviewAssignmentsByReviewers(mock.Anything, []string{"u1"}).
+		Return([]*entity.ReviewAssignment{}, nil)
+	prRepo.EXPECT().
+		UpdateReviewersBatch(mock.Anything, []*entity.ReviewerMove{}).
+		Return(nil)
+	userRepo.EXPECT().
+		UpdateUsersIsActiveByIds(mock.Anything, []string{"u1"}, false).
 		Return(nil)
 
 	res, err := uc.DeactivateTeamUsers(ctx, req)
 	require.NoError(t, err)
-	assert.Equal(t, req, res)
+	assert.Empty(t, res.Reassignments)
+
+	userRepo.AssertNotCalled(t, "GetReviewCandidatesByTeams", mock.Anything, mock.Anything, mock.Anything)
 }
 
-func TestDeactivateTeamUsers_GetPRs_Error(t *testing.T) {
+func TestDeactivateTeamUsers_GetAssignments_Error(t *testing.T) {
 	ctx := getTestContext()
 	uc, userRepo, prRepo := setupTest(t)
 
@@ -311,7 +455,7 @@ func TestDeactivateTeamUsers_GetPRs_Error(t *testing.T) {
 		Return(map[string]*entity.User{"u1": {UserId: "u1", TeamName: "teamA"}}, nil)
 
 	prRepo.EXPECT().
-		GetPullRequestsByReviewerId(mock.Anything, "u1").
+		GetOpenReviewAssignmentsByReviewers(mock.Anything, []string{"u1"}).
 		Return(nil, dbErr)
 
 	res, err := uc.DeactivateTeamUsers(ctx, req)
@@ -320,26 +464,26 @@ func TestDeactivateTeamUsers_GetPRs_Error(t *testing.T) {
 	assert.EqualError(t, err, dbErr.Error())
 }
 
-func TestDeactivateTeamUsers_FindNewReviewer_Error(t *testing.T) {
+func TestDeactivateTeamUsers_GetCandidates_Error(t *testing.T) {
 	ctx := getTestContext()
 	uc, userRepo, prRepo := setupTest(t)
 
 	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1"}}
-	dbErr := errors.New("find reviewer failed")
+	dbErr := errors.New("select candidates failed")
 
 	userRepo.EXPECT().
 		GetUsersByIds(mock.Anything, []string{"u1"}).
 		Return(map[string]*entity.User{"u1": {UserId: "u1", TeamName: "teamA"}}, nil)
 
 	prRepo.EXPECT().
-		GetPullRequestsByReviewerId(mock.Anything, "u1").
-		Return([]*entity.PullRequestShort{
-			{Id: "pr1", PrName: "A", AuthorId: "a1", Status: "OPEN"},
+		GetOpenReviewAssignmentsByReviewers(mock.Anything, []string{"u1"}).
+		Return([]*entity.ReviewAssignment{
+			{PullRequestId: "pr1", AuthorId: "a1", ReviewerId: "u1", TeamName: "teamA"},
 		}, nil)
 
 	userRepo.EXPECT().
-		FindNewReviewerExcluding(mock.Anything, "pr1", "a1", []string{"u1"}).
-		Return("", dbErr)
+		GetReviewCandidatesByTeams(mock.Anything, []string{"teamA"}, []string{"u1"}).
+		Return(nil, dbErr)
 
 	res, err := uc.DeactivateTeamUsers(ctx, req)
 	require.Error(t, err)
@@ -347,29 +491,39 @@ func TestDeactivateTeamUsers_FindNewReviewer_Error(t *testing.T) {
 	assert.EqualError(t, err, dbErr.Error())
 }
 
-func TestDeactivateTeamUsers_UpdateReviewer_Error(t *testing.T) {
+func TestDeactivateTeamUsers_UpdateReviewers_Error(t *testing.T) {
 	ctx := getTestContext()
 	uc, userRepo, prRepo := setupTest(t)
 
-	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1"}}
+	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1", "u2"}}
 	dbErr := errors.New("update reviewer failed")
-
-	userRepo.EXPECT().
-		GetUsersByIds(mock.Anything, []string{"u1"}).
-		Return(map[string]*entity.User{"u1": {UserId: "u1", TeamName: "teamA"}}, nil)
+	deactivateFixture(userRepo, prRepo)
 
 	prRepo.EXPECT().
-		GetPullRequestsByReviewerId(mock.Anything, "u1").
-		Return([]*entity.PullRequestShort{
-			{Id: "pr1", PrName: "A", AuthorId: "a1", Status: "OPEN"},
-		}, nil)
+		UpdateReviewersBatch(mock.Anything, mock.Anything).
+		Return(dbErr)
 
-	userRepo.EXPECT().
-		FindNewReviewerExcluding(mock.Anything, "pr1", "a1", []string{"u1"}).
-		Return("u3", nil)
+	res, err := uc.DeactivateTeamUsers(ctx, req)
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.EqualError(t, err, dbErr.Error())
+
+	userRepo.AssertNotCalled(t, "UpdateUsersIsActiveByIds", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDeactivateTeamUsers_UpdateUsersIsActive_Error(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1", "u2"}}
+	dbErr := errors.New("bulk deactivate failed")
+	deactivateFixture(userRepo, prRepo)
 
 	prRepo.EXPECT().
-		UpdateReviewerId(mock.Anything, "pr1", "u1", "u3").
+		UpdateReviewersBatch(mock.Anything, mock.Anything).
+		Return(nil)
+	userRepo.EXPECT().
+		UpdateUsersIsActiveByIds(mock.Anything, []string{"u1", "u2"}, false).
 		Return(dbErr)
 
 	res, err := uc.DeactivateTeamUsers(ctx, req)
@@ -378,27 +532,564 @@ func TestDeactivateTeamUsers_UpdateReviewer_Error(t *testing.T) {
 	assert.EqualError(t, err, dbErr.Error())
 }
 
-func TestDeactivateTeamUsers_UpdateUsersIsActive_Error(t *testing.T) {
+func TestPlanDeactivation_NoActiveMembers(t *testing.T) {
+	assignments := []*entity.ReviewAssignment{
+		{PullRequestId: "pr1", AuthorId: "a1", ReviewerId: "u1", TeamName: "teamA"},
+	}
+
+	reassignments := planDeactivation(assignments, nil, []string{"u1"})
+	assert.Equal(t, []*entity.ReviewerReassignment{
+		{PullRequestId: "pr1", OldReviewerId: "u1", Result: entity.ReassignmentNoCandidate, Reason: entity.ReassignmentReasonNoActiveMembers},
+	}, reassignments)
+}
+
+func TestPlanDeactivation_OnlyAuthorLeft(t *testing.T) {
+	assignments := []*entity.ReviewAssignment{
+		{PullRequestId: "pr1", AuthorId: "a1", ReviewerId: "u1", TeamName: "teamA"},
+		{PullRequestId: "pr2", AuthorId: "a1", ReviewerId: "u1", TeamName: "teamA"},
+		{PullRequestId: "pr2", AuthorId: "a1", ReviewerId: "u2", TeamName: "teamA"},
+	}
+	candidates := []*entity.ReviewCandidate{
+		{UserId: "a1", TeamName: "teamA"},
+		{UserId: "u2", TeamName: "teamA", OpenReviews: 1},
+	}
+
+	reassignments := planDeactivation(assignments[:1], candidates[:1], []string{"u1"})
+	assert.Equal(t, entity.ReassignmentReasonOnlyAuthor, reassignments[0].Reason)
+
+	reassignments = planDeactivation(assignments[1:], candidates, []string{"u1"})
+	assert.Equal(t, entity.ReassignmentReasonAllAssigned, reassignments[0].Reason)
+}
+
+func TestPlanDeactivation_BalancesLoad(t *testing.T) {
+	assignments := []*entity.ReviewAssignment{
+		{PullRequestId: "pr1", AuthorId: "a1", ReviewerId: "u1", TeamName: "teamA"},
+		{PullRequestId: "pr2", AuthorId: "a1", ReviewerId: "u1", TeamName: "teamA"},
+		{PullRequestId: "pr3", AuthorId: "a1", ReviewerId: "u1", TeamName: "teamA"},
+		{PullRequestId: "pr4", AuthorId: "a1", ReviewerId: "u1", TeamName: "teamA"},
+	}
+	candidates := []*entity.ReviewCandidate{
+		{UserId: "u2", TeamName: "teamA", OpenReviews: 2},
+		{UserId: "u3", TeamName: "teamA", OpenReviews: 0},
+		{UserId: "a1", TeamName: "teamA", OpenReviews: 0},
+	}
+
+	reassignments := planDeactivation(assignments, candidates, []string{"u1"})
+
+	got := make([]string, 0, len(reassignments))
+	for _, r := range reassignments {
+		require.NotNil(t, r.NewReviewerId)
+		got = append(got, *r.NewReviewerId)
+	}
+	assert.Equal(t, []string{"u3", "u3", "u2", "u3"}, got)
+}
+
+func TestSetReviewCapacity_InvalidValue(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _ := setupTest(t)
+
+	negative := -1
+	req := &entity.UserReviewCapacity{UserId: "u1", MaxOpenReviews: &negative}
+
+	res, err := uc.SetReviewCapacity(ctx, req)
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrInvalidReviewCapacity)
+
+	userRepo.AssertNotCalled(t, "SetReviewCapacity", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetReviewCapacity_UserNotExist(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _ := setupTest(t)
+
+	req := &entity.UserReviewCapacity{UserId: "u1"}
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(false, nil)
+
+	res, err := uc.SetReviewCapacity(ctx, req)
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrUserNotFound)
+}
+
+func TestSetReviewCapacity_Success(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _ := setupTest(t)
+
+	limit := 3
+	req := &entity.UserReviewCapacity{UserId: "u1", MaxOpenReviews: &limit}
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(true, nil)
+	userRepo.EXPECT().
+		SetReviewCapacity(mock.Anything, "u1", &limit).
+		Return(nil)
+
+	res, err := uc.SetReviewCapacity(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, req, res)
+}
+
+func TestReactivateTeamUsers_UsersNotSameTeam(t *testing.T) {
 	ctx := getTestContext()
 	uc, userRepo, prRepo := setupTest(t)
 
-	req := &entity.DeactivateUsers{TeamName: "teamA", UserIds: []string{"u1"}}
-	dbErr := errors.New("bulk deactivate failed")
+	req := &entity.ReactivateUsers{TeamName: "teamA", UserIds: []string{"u1"}, Rebalance: true}
+
+	userRepo.EXPECT().
+		GetUsersByIds(mock.Anything, []string{"u1"}).
+		Return(map[string]*entity.User{"u1": {UserId: "u1", TeamName: "teamB"}}, nil)
+
+	res, err := uc.ReactivateTeamUsers(ctx, req)
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrUsersNotSameTeam)
+
+	prRepo.AssertNotCalled(t, "GetOpenReviewAssignmentsByTeam", mock.Anything, mock.Anything)
+	userRepo.AssertNotCalled(t, "UpdateUsersIsActiveByIds", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReactivateTeamUsers_WithoutRebalance(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	req := &entity.ReactivateUsers{TeamName: "teamA", UserIds: []string{"u1"}}
 
 	userRepo.EXPECT().
 		GetUsersByIds(mock.Anything, []string{"u1"}).
 		Return(map[string]*entity.User{"u1": {UserId: "u1", TeamName: "teamA"}}, nil)
+	userRepo.EXPECT().
+		UpdateUsersIsActiveByIds(mock.Anything, []string{"u1"}, true).
+		Return(nil)
+
+	res, err := uc.ReactivateTeamUsers(ctx, req)
+	require.NoError(t, err)
+	assert.Empty(t, res.Moves)
+
+	prRepo.AssertNotCalled(t, "GetOpenReviewAssignmentsByTeam", mock.Anything, mock.Anything)
+}
 
+func reactivateRebalanceFixture(userRepo *mock_user.MockIRepository, prRepo *mock_pullrequest.MockIRepository) {
+	userRepo.EXPECT().
+		GetUsersByIds(mock.Anything, []string{"u4"}).
+		Return(map[string]*entity.User{"u4": {UserId: "u4", TeamName: "teamA"}}, nil)
+	userRepo.EXPECT().
+		GetMembersByTeamName(mock.Anything, "teamA").
+		Return([]*entity.TeamMember{
+			{UserID: "u1", IsActive: true},
+			{UserID: "u2", IsActive: true},
+			{UserID: "u3", IsActive: true},
+			{UserID: "u4", IsActive: false},
+		}, nil)
 	prRepo.EXPECT().
-		GetPullRequestsByReviewerId(mock.Anything, "u1").
-		Return([]*entity.PullRequestShort{}, nil)
+		GetOpenReviewAssignmentsByTeam(mock.Anything, "teamA").
+		Return([]*entity.ReviewAssignment{
+			{PullRequestId: "pr1", AuthorId: "u3", ReviewerId: "u1"},
+			{PullRequestId: "pr1", AuthorId: "u3", ReviewerId: "u2"},
+			{PullRequestId: "pr2", AuthorId: "u3", ReviewerId: "u1"},
+			{PullRequestId: "pr2", AuthorId: "u3", ReviewerId: "u2"},
+			{PullRequestId: "pr3", AuthorId: "u4", ReviewerId: "u1"},
+			{PullRequestId: "pr3", AuthorId: "u4", ReviewerId: "u2"},
+			{PullRequestId: "pr4", AuthorId: "u2", ReviewerId: "u1"},
+			{PullRequestId: "pr4", AuthorId: "u2", ReviewerId: "u3"},
+		}, nil)
+	userRepo.EXPECT().
+		GetReviewLoadsByIds(mock.Anything, []string{"u4"}).
+		Return([]*entity.ReviewCandidate{{UserId: "u4", TeamName: "teamA"}}, nil)
+}
+
+func TestReactivateTeamUsers_DryRun(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	req := &entity.ReactivateUsers{TeamName: "teamA", UserIds: []string{"u4"}, Rebalance: true, DryRun: true}
+	reactivateRebalanceFixture(userRepo, prRepo)
+
+	res, err := uc.ReactivateTeamUsers(ctx, req)
+	require.NoError(t, err)
+	assert.True(t, res.DryRun)
+	assert.Equal(t, []*entity.ReviewerMove{
+		{PullRequestId: "pr1", FromReviewerId: "u1", ToReviewerId: "u4"},
+		{PullRequestId: "pr2", FromReviewerId: "u1", ToReviewerId: "u4"},
+	}, res.Moves)
+
+	userRepo.AssertNotCalled(t, "UpdateUsersIsActiveByIds", mock.Anything, mock.Anything, mock.Anything)
+	prRepo.AssertNotCalled(t, "UpdateReviewerId", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReactivateTeamUsers_Rebalance(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	req := &entity.ReactivateUsers{TeamName: "teamA", UserIds: []string{"u4"}, Rebalance: true}
+	reactivateRebalanceFixture(userRepo, prRepo)
 
 	userRepo.EXPECT().
-		UpdateUsersIsActiveByIds(mock.Anything, []string{"u1"}, false).
-		Return(dbErr)
+		UpdateUsersIsActiveByIds(mock.Anything, []string{"u4"}, true).
+		Return(nil)
+	prRepo.EXPECT().
+		UpdateReviewerId(mock.Anything, "pr1", "u1", "u4").
+		Return(nil)
+	prRepo.EXPECT().
+		UpdateReviewerId(mock.Anything, "pr2", "u1", "u4").
+		Return(nil)
 
-	res, err := uc.DeactivateTeamUsers(ctx, req)
+	res, err := uc.ReactivateTeamUsers(ctx, req)
+	require.NoError(t, err)
+	assert.False(t, res.DryRun)
+	assert.Len(t, res.Moves, 2)
+}
+
+func TestPlanRebalance_PrefersInactiveReviewers(t *testing.T) {
+	assignments := []*entity.ReviewAssignment{
+		{PullRequestId: "pr1", AuthorId: "a", ReviewerId: "u1"},
+		{PullRequestId: "pr2", AuthorId: "a", ReviewerId: "u1"},
+		{PullRequestId: "pr3", AuthorId: "a", ReviewerId: "gone"},
+	}
+	activeIds := map[string]struct{}{"a": {}, "u1": {}, "u2": {}}
+
+	moves := planRebalance(assignments, activeIds, []string{"u2"}, nil)
+	assert.Equal(t, []*entity.ReviewerMove{
+		{PullRequestId: "pr3", FromReviewerId: "gone", ToReviewerId: "u2"},
+	}, moves)
+}
+
+func TestPlanRebalance_SkipsOwnAndAlreadyAssignedPRs(t *testing.T) {
+	assignments := []*entity.ReviewAssignment{
+		{PullRequestId: "pr1", AuthorId: "u2", ReviewerId: "u1"},
+		{PullRequestId: "pr2", AuthorId: "a", ReviewerId: "u1"},
+		{PullRequestId: "pr2", AuthorId: "a", ReviewerId: "u2"},
+		{PullRequestId: "pr3", AuthorId: "a", ReviewerId: "u1"},
+	}
+	activeIds := map[string]struct{}{"u1": {}, "u2": {}}
+
+	moves := planRebalance(assignments, activeIds, []string{"u2"}, nil)
+	assert.Equal(t, []*entity.ReviewerMove{
+		{PullRequestId: "pr3", FromReviewerId: "u1", ToReviewerId: "u2"},
+	}, moves)
+}
+
+func TestPlanRebalance_StopsAtTargetCapacity(t *testing.T) {
+	assignments := []*entity.ReviewAssignment{
+		{PullRequestId: "pr1", AuthorId: "a", ReviewerId: "gone"},
+		{PullRequestId: "pr2", AuthorId: "a", ReviewerId: "gone"},
+		{PullRequestId: "pr3", AuthorId: "a", ReviewerId: "gone"},
+		{PullRequestId: "pr4", AuthorId: "a", ReviewerId: "gone"},
+	}
+	activeIds := map[string]struct{}{"u1": {}, "u2": {}}
+
+	moves := planRebalance(assignments, activeIds, []string{"u1", "u2"}, map[string]int{"u1": 1, "u2": 0})
+	assert.Equal(t, []*entity.ReviewerMove{
+		{PullRequestId: "pr1", FromReviewerId: "gone", ToReviewerId: "u1"},
+	}, moves)
+}
+
+func TestCreateUser_AlreadyExists(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _, _ := setupTestWithTeam(t)
+
+	req := &entity.UserCreate{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(true, nil)
+
+	res, err := uc.CreateUser(ctx, req)
 	require.Error(t, err)
 	assert.Nil(t, res)
-	assert.EqualError(t, err, dbErr.Error())
+	assert.ErrorIs(t, err, entity.ErrUserExist)
+}
+
+func TestCreateUser_TeamNotFound(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _, teamRepo := setupTestWithTeam(t)
+
+	req := &entity.UserCreate{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(false, nil)
+	teamRepo.EXPECT().
+		CheckTeamNameExist(mock.Anything, "teamA").
+		Return(false, nil)
+
+	res, err := uc.CreateUser(ctx, req)
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrTeamNameNotFound)
+}
+
+func TestCreateUser_Success(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _, teamRepo := setupTestWithTeam(t)
+
+	req := &entity.UserCreate{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(false, nil)
+	teamRepo.EXPECT().
+		CheckTeamNameExist(mock.Anything, "teamA").
+		Return(true, nil)
+	userRepo.EXPECT().
+		CreateUser(mock.Anything, req).
+		Return(nil)
+
+	res, err := uc.CreateUser(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, &entity.User{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}, res)
+}
+
+func TestUpdateUser_NothingToUpdate(t *testing.T) {
+	ctx := getTestContext()
+	uc, _, _, _ := setupTestWithTeam(t)
+
+	res, err := uc.UpdateUser(ctx, &entity.UserUpdate{UserId: "u1"})
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrNothingToUpdate)
+}
+
+func TestUpdateUser_UserNotFound(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _, _ := setupTestWithTeam(t)
+
+	username := "bob"
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(false, nil)
+
+	res, err := uc.UpdateUser(ctx, &entity.UserUpdate{UserId: "u1", Username: &username})
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrUserNotFound)
+}
+
+func TestUpdateUser_Success(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _, teamRepo := setupTestWithTeam(t)
+
+	username := "bob"
+	teamName := "teamB"
+	want := &entity.User{UserId: "u1", Username: "bob", TeamName: "teamB", IsActive: true}
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(true, nil)
+	teamRepo.EXPECT().
+		CheckTeamNameExist(mock.Anything, "teamB").
+		Return(true, nil)
+	userRepo.EXPECT().
+		UpdateUser(mock.Anything, "u1", &username, &teamName).
+		Return(nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u1").
+		Return(want, nil)
+
+	res, err := uc.UpdateUser(ctx, &entity.UserUpdate{UserId: "u1", Username: &username, TeamName: &teamName})
+	require.NoError(t, err)
+	assert.Equal(t, want, res)
+}
+
+func TestPatchUser_SetIsActiveFails_RollsBackInTx(t *testing.T) {
+	ctx := getTestContext()
+	userRepo := mock_user.NewMockIRepository(t)
+	teamRepo := mock_team.NewMockIRepository(t)
+	transactor := mock_outbox.NewMockITransactor(t)
+	uc := NewUsecase(userRepo, mock_pullrequest.NewMockIRepository(t), teamRepo, mock_outbox.NewMockIRepository(t), transactor)
+
+	username := "bob"
+	isActive := false
+	dbErr := errors.New("db failure")
+
+	var inTx bool
+	transactor.EXPECT().
+		WithinTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			inTx = true
+			defer func() { inTx = false }()
+			return fn(ctx)
+		}).
+		Once()
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(true, nil)
+	userRepo.EXPECT().
+		UpdateUser(mock.Anything, "u1", &username, (*string)(nil)).
+		RunAndReturn(func(ctx context.Context, userId string, username *string, teamName *string) error {
+			assert.True(t, inTx)
+			return nil
+		})
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u1").
+		Return(&entity.User{UserId: "u1", Username: "bob", IsActive: true}, nil)
+	userRepo.EXPECT().
+		SetIsActive(mock.Anything, "u1", false).
+		RunAndReturn(func(ctx context.Context, userId string, isActive bool) error {
+			assert.True(t, inTx)
+			return dbErr
+		})
+
+	res, err := uc.PatchUser(ctx, &entity.UserPatch{UserId: "u1", Username: &username, IsActive: &isActive})
+	assert.ErrorIs(t, err, dbErr)
+	assert.Nil(t, res)
+}
+
+func TestPatchUser_NothingToUpdate(t *testing.T) {
+	ctx := getTestContext()
+	uc, _, _, _ := setupTestWithTeam(t)
+
+	res, err := uc.PatchUser(ctx, &entity.UserPatch{UserId: "u1"})
+	assert.ErrorIs(t, err, entity.ErrNothingToUpdate)
+	assert.Nil(t, res)
+}
+
+func TestListUsers_InvalidPagination(t *testing.T) {
+	ctx := getTestContext()
+	uc, _, _, _ := setupTestWithTeam(t)
+
+	res, err := uc.ListUsers(ctx, &entity.UserListFilter{Limit: entity.MaxPageLimit + 1})
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrInvalidPagination)
+}
+
+func TestListUsers_DefaultLimit(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _, _ := setupTestWithTeam(t)
+
+	isActive := true
+	filter := &entity.UserListFilter{TeamName: "teamA", IsActive: &isActive, Search: "ali"}
+	users := []*entity.User{{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}}
+
+	userRepo.EXPECT().
+		ListUsers(mock.Anything, filter).
+		Return(users, nil)
+	userRepo.EXPECT().
+		CountUsers(mock.Anything, filter).
+		Return(1, nil)
+
+	res, err := uc.ListUsers(ctx, filter)
+	require.NoError(t, err)
+	assert.Equal(t, &entity.UserList{Users: users, Total: 1, Limit: entity.DefaultPageLimit, Offset: 0}, res)
+}
+
+func TestDeleteUser_NotFound(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, _ := setupTest(t)
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(false, nil)
+
+	res, err := uc.DeleteUser(ctx, &entity.UserDelete{UserId: "u1"})
+	require.Error(t, err)
+	assert.Nil(t, res)
+	assert.ErrorIs(t, err, entity.ErrUserNotFound)
+}
+
+func TestDeleteUser_ReassignsAndAnonymizes(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u1").
+		Return(true, nil)
+	userRepo.EXPECT().
+		GetUserById(mock.Anything, "u1").
+		Return(&entity.User{UserId: "u1", Username: "alice", TeamName: "teamA", IsActive: true}, nil)
+	userRepo.EXPECT().
+		GetUsersByIds(mock.Anything, []string{"u1"}).
+		Return(map[string]*entity.User{"u1": {UserId: "u1", TeamName: "teamA"}}, nil)
+	prRepo.EXPECT().
+		GetOpenReviewAssignmentsByReviewers(mock.Anything, []string{"u1"}).
+		Return([]*entity.ReviewAssignment{
+			{PullRequestId: "pr1", AuthorId: "u2", ReviewerId: "u1", TeamName: "teamA"},
+		}, nil)
+	userRepo.EXPECT().
+		GetReviewCandidatesByTeams(mock.Anything, []string{"teamA"}, []string{"u1"}).
+		Return([]*entity.ReviewCandidate{
+			{UserId: "u3", TeamName: "teamA", OpenReviews: 0},
+		}, nil)
+	prRepo.EXPECT().
+		UpdateReviewersBatch(mock.Anything, []*entity.ReviewerMove{
+			{PullRequestId: "pr1", FromReviewerId: "u1", ToReviewerId: "u3"},
+		}).
+		Return(nil)
+	userRepo.EXPECT().
+		UpdateUsersIsActiveByIds(mock.Anything, []string{"u1"}, false).
+		Return(nil)
+	userRepo.EXPECT().
+		SoftDeleteUser(mock.Anything, "u1", entity.DeletedUsername).
+		Return(nil)
+
+	res, err := uc.DeleteUser(ctx, &entity.UserDelete{UserId: "u1"})
+	require.NoError(t, err)
+	assert.Equal(t, "u1", res.UserId)
+	require.Len(t, res.Reassignments, 1)
+	assert.Equal(t, entity.ReassignmentReassigned, res.Reassignments[0].Result)
+	assert.Equal(t, 1, res.Summary.Reassigned)
+}
+
+func TestGetUserAuthored_UserNotExist(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "u-missing").
+		Return(false, nil)
+
+	got, err := uc.GetUserAuthored(ctx, &entity.PullRequestFilter{AuthorId: "u-missing"})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrUserNotFound)
+
+	prRepo.AssertNotCalled(t, "GetPullRequestsByAuthorId", mock.Anything, mock.Anything)
+}
+
+func TestGetUserAuthored_ReviewersWithState(t *testing.T) {
+	ctx := getTestContext()
+	uc, userRepo, prRepo := setupTest(t)
+
+	prs := []*entity.PullRequestShort{
+		{Id: "pr2", AuthorId: "a1", Status: "OPEN"},
+		{Id: "pr1", AuthorId: "a1", Status: "MERGED"},
+	}
+
+	userRepo.EXPECT().
+		CheckUserExistById(mock.Anything, "a1").
+		Return(true, nil)
+	prRepo.EXPECT().
+		GetPullRequestsByAuthorId(mock.Anything, &entity.PullRequestFilter{AuthorId: "a1", Order: entity.SortOrderDesc, Limit: entity.DefaultPageLimit + 1}).
+		Return(prs, nil)
+	prRepo.EXPECT().
+		CountPullRequestsByAuthorId(mock.Anything, mock.Anything).
+		Return(2, nil)
+	prRepo.EXPECT().
+		GetReviewersByPrIds(mock.Anything, []string{"pr2", "pr1"}).
+		Return(map[string][]*entity.PullRequestReviewer{
+			"pr2": {
+				{UserId: "r1", IsActive: true},
+				{UserId: "r2", IsActive: false},
+			},
+			"pr1": {
+				{UserId: "r1", IsActive: true},
+			},
+		}, nil)
+
+	got, err := uc.GetUserAuthored(ctx, &entity.PullRequestFilter{AuthorId: "a1"})
+	require.NoError(t, err)
+	assert.Equal(t, "a1", got.UserId)
+	assert.Equal(t, 2, got.Total)
+	assert.Empty(t, got.NextCursor)
+	require.Len(t, got.PullRequests, 2)
+
+	assert.Equal(t, entity.ReviewStatePending, got.PullRequests[0].Reviewers[0].State)
+	assert.Equal(t, entity.ReviewStateReviewerInactive, got.PullRequests[0].Reviewers[1].State)
+	assert.Equal(t, entity.ReviewStateCompleted, got.PullRequests[1].Reviewers[0].State)
 }
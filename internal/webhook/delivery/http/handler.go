@@ -0,0 +1,123 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/asaskevich/govalidator"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/errmap"
+	"github.com/Mockird31/avito_tech/internal/webhook"
+	json "github.com/Mockird31/avito_tech/pkg/json"
+	"github.com/Mockird31/avito_tech/pkg/query"
+)
+
+type Handler struct {
+	usecase webhook.IUsecase
+}
+
+func NewHandler(usecase webhook.IUsecase) *Handler {
+	return &Handler{
+		usecase: usecase,
+	}
+}
+
+func (h *Handler) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var subscriptionCreate entity.WebhookSubscriptionCreate
+
+	err := json.ReadJSON(w, r, &subscriptionCreate)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(subscriptionCreate)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	subscription, err := h.usecase.CreateSubscription(ctx, &subscriptionCreate)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusCreated, &entity.WebhookResponse{Webhook: subscription}, nil)
+}
+
+func (h *Handler) ListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	subscriptions, err := h.usecase.ListSubscriptions(ctx)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.WebhookListResponse{Webhooks: subscriptions}, nil)
+}
+
+func (h *Handler) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var webhookDelete entity.WebhookDelete
+
+	err := json.ReadJSON(w, r, &webhookDelete)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "failed to parse request")
+		return
+	}
+
+	isValid, err := govalidator.ValidateStruct(webhookDelete)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if !isValid {
+		json.WriteErrorJson(w, http.StatusBadRequest, "wrong json")
+		return
+	}
+
+	err = h.usecase.DeleteSubscription(ctx, webhookDelete.Id)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ListDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	values := r.URL.Query()
+	subscriptionId, err := strconv.ParseInt(values.Get("id"), 10, 64)
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, "invalid query parameter: id")
+		return
+	}
+
+	limit, err := query.Int(values, "limit")
+	if err != nil {
+		json.WriteErrorJson(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	deliveries, err := h.usecase.ListDeliveries(ctx, subscriptionId, limit)
+	if err != nil {
+		json.WriteErrorJson(w, errmap.HTTPStatus(err), err.Error())
+		return
+	}
+
+	json.WriteJSON(w, http.StatusOK, &entity.WebhookDeliveriesResponse{Deliveries: deliveries}, nil)
+}
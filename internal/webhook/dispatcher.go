@@ -0,0 +1,12 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+// IDispatcher доставляет доменное событие подписчикам вебхуков и ждет результата доставки.
+type IDispatcher interface {
+	Deliver(ctx context.Context, event *entity.DomainEvent) error
+}
@@ -0,0 +1,56 @@
+package dispatcher
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// sharedAddressSpace - 100.64.0.0/10 (RFC 6598), адреса провайдерского NAT и внутренних сетей облаков.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// newClient возвращает клиент, который не подключается к внутренним адресам: URL подписки задает
+// пользователь API, и без проверки через вебхук можно достучаться до сервисов внутри сети.
+// Адрес проверяется при подключении, после резолва имени, поэтому проверку не обойти DNS-записью
+// на внутренний адрес или редиректом. allowPrivate отключает проверку для локального окружения.
+func newClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   denyPrivateAddress,
+		}
+		transport.DialContext = dialer.DialContext
+		// через прокси подключение шло бы к адресу прокси, и проверка не видела бы адрес подписки
+		transport.Proxy = nil
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+func denyPrivateAddress(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !isPublicAddress(addr) {
+		return fmt.Errorf("webhook target %s is not a public address", addr)
+	}
+	return nil
+}
+
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!addr.IsLoopback() &&
+		!addr.IsLinkLocalUnicast() &&
+		!sharedAddressSpace.Contains(addr)
+}
@@ -0,0 +1,174 @@
+package dispatcher
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/webhook"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// SignatureHeader содержит HMAC-SHA256 тела запроса на секрете подписки: "sha256=<hex>".
+	SignatureHeader      = "X-Webhook-Signature"
+	EventHeader          = "X-Webhook-Event"
+	IdempotencyKeyHeader = "X-Webhook-Idempotency-Key"
+)
+
+// Dispatcher доставляет событие подписчикам, не больше workers одновременно. За один вызов каждая подписка
+// получает одну попытку, каждая попытка пишется в журнал; повтор с задержкой делает outbox, поэтому
+// медленный получатель не держит relay дольше таймаута запроса.
+type Dispatcher struct {
+	repository  webhook.IRepository
+	client      *http.Client
+	workers     int
+	maxAttempts int
+}
+
+func NewDispatcher(repository webhook.IRepository, cfg config.WebhookConfig) *Dispatcher {
+	return &Dispatcher{
+		repository:  repository,
+		client:      newClient(cfg.Timeout, cfg.AllowPrivateTargets),
+		workers:     max(cfg.Workers, 1),
+		maxAttempts: max(cfg.MaxAttempts, 1),
+	}
+}
+
+// Deliver отправляет событие всем подпискам на его тип и возвращает ошибку, если хотя бы одна подписка
+// его не приняла и у нее остались попытки. Подписки, которые уже приняли событие с тем же IdempotencyKey
+// или исчерпали maxAttempts, пропускаются, поэтому повторный вызов досылает событие только остальным.
+func (d *Dispatcher) Deliver(ctx context.Context, event *entity.DomainEvent) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.Error("failed to marshal event (Deliver)", zap.Error(err), zap.String("event_type", event.Type))
+		return err
+	}
+
+	subscriptions, err := d.repository.GetSubscriptionsByEvent(ctx, event.Type)
+	if err != nil {
+		return err
+	}
+
+	states := make(map[int64]*entity.WebhookDeliveryState)
+	if event.IdempotencyKey != "" && len(subscriptions) > 0 {
+		states, err = d.repository.GetDeliveryStates(ctx, event.IdempotencyKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+		sem  = make(chan struct{}, d.workers)
+	)
+	for _, subscription := range subscriptions {
+		attempt := 1
+		if state, ok := states[subscription.Id]; ok {
+			if state.Delivered || state.Attempts >= d.maxAttempts {
+				continue
+			}
+			attempt = state.Attempts + 1
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			if err := d.deliver(ctx, subscription, event, payload, attempt); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("subscription %d: %w", subscription.Id, err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// deliver делает одну попытку доставки. Ошибка возвращается, только если после нее остались попытки:
+// на последней неудаче подписка больше не получает событие, и повтор outbox'а ее пропустит.
+func (d *Dispatcher) deliver(ctx context.Context, subscription *entity.WebhookSubscription, event *entity.DomainEvent, payload []byte, attempt int) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	statusCode, err := d.send(ctx, subscription, event, payload)
+
+	delivery := &entity.WebhookDelivery{
+		SubscriptionId: subscription.Id,
+		EventType:      event.Type,
+		IdempotencyKey: event.IdempotencyKey,
+		Payload:        payload,
+		Attempt:        attempt,
+		StatusCode:     statusCode,
+		Success:        err == nil,
+	}
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+	if recordErr := d.repository.RecordDelivery(ctx, delivery); recordErr != nil {
+		logger.Error("failed to record webhook delivery (deliver)", zap.Error(recordErr))
+	}
+
+	if delivery.Success {
+		return nil
+	}
+	// без IdempotencyKey попытки нельзя сосчитать по журналу, поэтому такое событие не повторяется
+	if attempt >= d.maxAttempts || event.IdempotencyKey == "" {
+		logger.Error("webhook delivery failed (deliver)", zap.Int64("subscription_id", subscription.Id), zap.String("event_type", event.Type), zap.Int("attempts", attempt), zap.Error(err))
+		return nil
+	}
+	return err
+}
+
+func (d *Dispatcher) send(ctx context.Context, subscription *entity.WebhookSubscription, event *entity.DomainEvent, payload []byte) (*int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event.Type)
+	if event.IdempotencyKey != "" {
+		req.Header.Set(IdempotencyKeyHeader, event.IdempotencyKey)
+	}
+	req.Header.Set(SignatureHeader, Sign(subscription.Secret, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	statusCode := resp.StatusCode
+	if statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		return &statusCode, fmt.Errorf("unexpected status code %d", statusCode)
+	}
+	return &statusCode, nil
+}
+
+// Sign считает подпись тела запроса, по которой получатель проверяет, что вебхук отправлен сервисом.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,216 @@
+package dispatcher
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Mockird31/avito_tech/config"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	mock_webhook "github.com/Mockird31/avito_tech/mocks/webhook"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestDeliver_SignsAndLeavesRetryToOutbox(t *testing.T) {
+	ctx := getTestContext()
+	secret := "0123456789abcdef"
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, Sign(secret, body), r.Header.Get(SignatureHeader))
+		assert.Equal(t, entity.EventTypePullRequestMerged, r.Header.Get(EventHeader))
+		assert.Equal(t, "key-1", r.Header.Get(IdempotencyKeyHeader))
+
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	repo := mock_webhook.NewMockIRepository(t)
+	repo.EXPECT().
+		GetSubscriptionsByEvent(mock.Anything, entity.EventTypePullRequestMerged).
+		Return([]*entity.WebhookSubscription{{Id: 7, Url: srv.URL, Secret: secret}}, nil)
+	repo.EXPECT().
+		GetDeliveryStates(mock.Anything, "key-1").
+		Return(map[int64]*entity.WebhookDeliveryState{}, nil).
+		Once()
+	repo.EXPECT().
+		RecordDelivery(mock.Anything, mock.MatchedBy(func(d *entity.WebhookDelivery) bool {
+			return d.Attempt == 1 && !d.Success && d.StatusCode != nil && *d.StatusCode == http.StatusServiceUnavailable
+		})).
+		Return(nil).
+		Once()
+
+	d := NewDispatcher(repo, config.WebhookConfig{Workers: 1, MaxAttempts: 3, Timeout: time.Second, AllowPrivateTargets: true})
+	event := &entity.DomainEvent{IdempotencyKey: "key-1", Type: entity.EventTypePullRequestMerged, PullRequestId: "pr1"}
+
+	// первая попытка не ждет повтора внутри вызова, а возвращает ошибку outbox'у
+	err := d.Deliver(ctx, event)
+	assert.ErrorContains(t, err, "subscription 7")
+	assert.Equal(t, int32(1), calls.Load())
+
+	repo.EXPECT().
+		GetDeliveryStates(mock.Anything, "key-1").
+		Return(map[int64]*entity.WebhookDeliveryState{7: {Attempts: 1}}, nil).
+		Once()
+	repo.EXPECT().
+		RecordDelivery(mock.Anything, mock.MatchedBy(func(d *entity.WebhookDelivery) bool {
+			return d.Attempt == 2 && d.Success && d.Error == "" && d.IdempotencyKey == "key-1"
+		})).
+		Return(nil).
+		Once()
+
+	err = d.Deliver(ctx, event)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestDeliver_GivesUpAfterMaxAttempts(t *testing.T) {
+	ctx := getTestContext()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	repo := mock_webhook.NewMockIRepository(t)
+	repo.EXPECT().
+		GetSubscriptionsByEvent(mock.Anything, entity.EventTypeReviewerAssigned).
+		Return([]*entity.WebhookSubscription{
+			{Id: 7, Url: srv.URL, Secret: "0123456789abcdef"},
+			{Id: 8, Url: srv.URL, Secret: "0123456789abcdef"},
+		}, nil)
+	repo.EXPECT().
+		GetDeliveryStates(mock.Anything, "key-1").
+		Return(map[int64]*entity.WebhookDeliveryState{7: {Attempts: 1}, 8: {Attempts: 2}}, nil)
+	repo.EXPECT().
+		RecordDelivery(mock.Anything, mock.MatchedBy(func(d *entity.WebhookDelivery) bool {
+			return d.SubscriptionId == 7 && d.Attempt == 2 && !d.Success
+		})).
+		Return(nil).
+		Once()
+
+	d := NewDispatcher(repo, config.WebhookConfig{Workers: 1, MaxAttempts: 2, Timeout: time.Second, AllowPrivateTargets: true})
+	err := d.Deliver(ctx, &entity.DomainEvent{IdempotencyKey: "key-1", Type: entity.EventTypeReviewerAssigned, PullRequestId: "pr1", ReviewerId: "u2"})
+	require.NoError(t, err)
+
+	// подписка 8 уже исчерпала попытки, 7 исчерпала их сейчас
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestDeliver_SkipsAlreadyDeliveredSubscriptions(t *testing.T) {
+	ctx := getTestContext()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Equal(t, "/pending", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	repo := mock_webhook.NewMockIRepository(t)
+	repo.EXPECT().
+		GetSubscriptionsByEvent(mock.Anything, entity.EventTypeReviewerReassigned).
+		Return([]*entity.WebhookSubscription{
+			{Id: 1, Url: srv.URL + "/delivered", Secret: "0123456789abcdef"},
+			{Id: 2, Url: srv.URL + "/pending", Secret: "0123456789abcdef"},
+		}, nil)
+	repo.EXPECT().
+		GetDeliveryStates(mock.Anything, "key-1").
+		Return(map[int64]*entity.WebhookDeliveryState{1: {Delivered: true, Attempts: 1}}, nil)
+	repo.EXPECT().
+		RecordDelivery(mock.Anything, mock.MatchedBy(func(d *entity.WebhookDelivery) bool {
+			return d.SubscriptionId == 2 && d.Attempt == 1 && d.Success
+		})).
+		Return(nil).
+		Once()
+
+	d := NewDispatcher(repo, config.WebhookConfig{Workers: 2, MaxAttempts: 1, Timeout: time.Second, AllowPrivateTargets: true})
+	err := d.Deliver(ctx, &entity.DomainEvent{IdempotencyKey: "key-1", Type: entity.EventTypeReviewerReassigned, PullRequestId: "pr1", ReviewerId: "u3", OldReviewerId: "u2"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestDeliver_RejectsPrivateTargets(t *testing.T) {
+	ctx := getTestContext()
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	repo := mock_webhook.NewMockIRepository(t)
+	repo.EXPECT().
+		GetSubscriptionsByEvent(mock.Anything, entity.EventTypePullRequestMerged).
+		Return([]*entity.WebhookSubscription{{Id: 7, Url: srv.URL, Secret: "0123456789abcdef"}}, nil)
+	repo.EXPECT().
+		GetDeliveryStates(mock.Anything, "key-1").
+		Return(map[int64]*entity.WebhookDeliveryState{}, nil)
+	repo.EXPECT().
+		RecordDelivery(mock.Anything, mock.MatchedBy(func(d *entity.WebhookDelivery) bool {
+			return !d.Success && d.StatusCode == nil && strings.Contains(d.Error, "is not a public address")
+		})).
+		Return(nil).
+		Once()
+
+	d := NewDispatcher(repo, config.WebhookConfig{Workers: 1, MaxAttempts: 1, Timeout: time.Second})
+	err := d.Deliver(ctx, &entity.DomainEvent{IdempotencyKey: "key-1", Type: entity.EventTypePullRequestMerged, PullRequestId: "pr1"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(0), calls.Load())
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"224.0.0.1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			assert.Equal(t, tt.want, isPublicAddress(netip.MustParseAddr(tt.addr)))
+		})
+	}
+}
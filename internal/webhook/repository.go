@@ -0,0 +1,18 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+type IRepository interface {
+	CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscriptionCreate) (*entity.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	CheckSubscriptionExist(ctx context.Context, id int64) (bool, error)
+	GetSubscriptionsByEvent(ctx context.Context, eventType string) ([]*entity.WebhookSubscription, error)
+	GetDeliveryStates(ctx context.Context, idempotencyKey string) (map[int64]*entity.WebhookDeliveryState, error)
+	RecordDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error
+	ListDeliveries(ctx context.Context, subscriptionId int64, limit int) ([]*entity.WebhookDelivery, error)
+}
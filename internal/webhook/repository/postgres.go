@@ -0,0 +1,273 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/webhook"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	CreateSubscriptionQuery = `
+		INSERT INTO webhook_subscription (url, secret, event_types)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at;
+	`
+	ListSubscriptionsQuery = `
+		SELECT id, url, event_types, created_at
+		FROM webhook_subscription
+		ORDER BY id;
+	`
+	DeleteSubscriptionQuery = `
+		DELETE FROM webhook_subscription
+		WHERE id = $1;
+	`
+	CheckSubscriptionExistQuery = `
+		SELECT 1
+		FROM webhook_subscription
+		WHERE id = $1;
+	`
+	GetSubscriptionsByEventQuery = `
+		SELECT id, url, secret, event_types, created_at
+		FROM webhook_subscription
+		WHERE cardinality(event_types) = 0 OR $1 = ANY(event_types)
+		ORDER BY id;
+	`
+	GetDeliveryStatesQuery = `
+		SELECT subscription_id, BOOL_OR(success), COUNT(*)
+		FROM webhook_delivery
+		WHERE idempotency_key = $1
+		GROUP BY subscription_id;
+	`
+	RecordDeliveryQuery = `
+		INSERT INTO webhook_delivery (subscription_id, event_type, idempotency_key, payload, attempt, status_code, error, success)
+		VALUES ($1, $2, NULLIF($3, '')::uuid, $4, $5, $6, $7, $8);
+	`
+	ListDeliveriesQuery = `
+		SELECT id, subscription_id, event_type, COALESCE(idempotency_key::text, ''), payload, attempt, status_code, error, success, created_at
+		FROM webhook_delivery
+		WHERE subscription_id = $1
+		ORDER BY id DESC
+		LIMIT $2;
+	`
+)
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) webhook.IRepository {
+	return &repository{
+		db: db,
+	}
+}
+
+func (r *repository) CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscriptionCreate) (*entity.WebhookSubscription, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	created := &entity.WebhookSubscription{
+		Url:        subscription.Url,
+		Secret:     subscription.Secret,
+		EventTypes: subscription.EventTypes,
+	}
+	err := r.db.QueryRowContext(ctx, CreateSubscriptionQuery, subscription.Url, subscription.Secret, pq.Array(subscription.EventTypes)).
+		Scan(&created.Id, &created.CreatedAt)
+	if err != nil {
+		logger.Error("failed to create webhook subscription (CreateSubscription)", zap.Error(err))
+		return nil, err
+	}
+	return created, nil
+}
+
+func (r *repository) ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, ListSubscriptionsQuery)
+	if err != nil {
+		logger.Error("failed to list webhook subscriptions (ListSubscriptions)", zap.Error(err))
+		return nil, err
+	}
+
+	return scanSubscriptions(ctx, rows, false)
+}
+
+func (r *repository) DeleteSubscription(ctx context.Context, id int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	res, err := r.db.ExecContext(ctx, DeleteSubscriptionQuery, id)
+	if err != nil {
+		logger.Error("failed to delete webhook subscription (DeleteSubscription)", zap.Error(err), zap.Int64("id", id))
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		logger.Error("failed to get rows affected (DeleteSubscription)", zap.Error(err))
+		return err
+	}
+	if affected == 0 {
+		return entity.ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *repository) CheckSubscriptionExist(ctx context.Context, id int64) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var isExist bool
+	err := r.db.QueryRowContext(ctx, CheckSubscriptionExistQuery, id).Scan(&isExist)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		logger.Error("failed to check webhook subscription (CheckSubscriptionExist)", zap.Error(err), zap.Int64("id", id))
+		return false, err
+	}
+	return isExist, nil
+}
+
+func (r *repository) GetSubscriptionsByEvent(ctx context.Context, eventType string) ([]*entity.WebhookSubscription, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, GetSubscriptionsByEventQuery, eventType)
+	if err != nil {
+		logger.Error("failed to get webhook subscriptions (GetSubscriptionsByEvent)", zap.Error(err), zap.String("event_type", eventType))
+		return nil, err
+	}
+
+	return scanSubscriptions(ctx, rows, true)
+}
+
+func scanSubscriptions(ctx context.Context, rows *sql.Rows, withSecret bool) (subscriptions []*entity.WebhookSubscription, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	subscriptions = make([]*entity.WebhookSubscription, 0)
+	for rows.Next() {
+		var subscription entity.WebhookSubscription
+		dest := []any{&subscription.Id, &subscription.Url}
+		if withSecret {
+			dest = append(dest, &subscription.Secret)
+		}
+		dest = append(dest, pq.Array(&subscription.EventTypes), &subscription.CreatedAt)
+		if err := rows.Scan(dest...); err != nil {
+			logger.Error("scan error (scanSubscriptions)", zap.Error(err))
+			return nil, err
+		}
+		subscriptions = append(subscriptions, &subscription)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (scanSubscriptions)", zap.Error(err))
+		return nil, err
+	}
+	return subscriptions, nil
+}
+
+func (r *repository) GetDeliveryStates(ctx context.Context, idempotencyKey string) (states map[int64]*entity.WebhookDeliveryState, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, GetDeliveryStatesQuery, idempotencyKey)
+	if err != nil {
+		logger.Error("failed to get delivery states (GetDeliveryStates)", zap.Error(err), zap.String("idempotency_key", idempotencyKey))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (GetDeliveryStates)", zap.Error(err))
+		}
+	}()
+
+	states = make(map[int64]*entity.WebhookDeliveryState)
+	for rows.Next() {
+		var (
+			id    int64
+			state entity.WebhookDeliveryState
+		)
+		if err := rows.Scan(&id, &state.Delivered, &state.Attempts); err != nil {
+			logger.Error("scan error (GetDeliveryStates)", zap.Error(err))
+			return nil, err
+		}
+		states[id] = &state
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (GetDeliveryStates)", zap.Error(err))
+		return nil, err
+	}
+	return states, nil
+}
+
+func (r *repository) RecordDelivery(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	_, err := r.db.ExecContext(ctx, RecordDeliveryQuery,
+		delivery.SubscriptionId,
+		delivery.EventType,
+		delivery.IdempotencyKey,
+		[]byte(delivery.Payload),
+		delivery.Attempt,
+		delivery.StatusCode,
+		delivery.Error,
+		delivery.Success,
+	)
+	if err != nil {
+		logger.Error("failed to record webhook delivery (RecordDelivery)", zap.Error(err), zap.Int64("subscription_id", delivery.SubscriptionId))
+		return err
+	}
+	return nil
+}
+
+func (r *repository) ListDeliveries(ctx context.Context, subscriptionId int64, limit int) (deliveries []*entity.WebhookDelivery, err error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, ListDeliveriesQuery, subscriptionId, limit)
+	if err != nil {
+		logger.Error("failed to list webhook deliveries (ListDeliveries)", zap.Error(err), zap.Int64("subscription_id", subscriptionId))
+		return nil, err
+	}
+	defer func() {
+		closeErr := rows.Close()
+		if closeErr != nil && err == nil {
+			err = closeErr
+			logger.Error("failed to close rows (ListDeliveries)", zap.Error(err))
+		}
+	}()
+
+	deliveries = make([]*entity.WebhookDelivery, 0)
+	for rows.Next() {
+		var (
+			delivery   entity.WebhookDelivery
+			payload    []byte
+			statusCode sql.NullInt64
+		)
+		err := rows.Scan(&delivery.Id, &delivery.SubscriptionId, &delivery.EventType, &delivery.IdempotencyKey, &payload, &delivery.Attempt, &statusCode, &delivery.Error, &delivery.Success, &delivery.CreatedAt)
+		if err != nil {
+			logger.Error("scan error (ListDeliveries)", zap.Error(err))
+			return nil, err
+		}
+		delivery.Payload = payload
+		if statusCode.Valid {
+			code := int(statusCode.Int64)
+			delivery.StatusCode = &code
+		}
+		deliveries = append(deliveries, &delivery)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("rows iterate error (ListDeliveries)", zap.Error(err))
+		return nil, err
+	}
+	return deliveries, nil
+}
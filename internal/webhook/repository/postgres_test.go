@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/Mockird31/avito_tech/internal/entity"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, *repository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	return db, mock, &repository{db: db}
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestCreateSubscription_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	events := []string{entity.EventTypePullRequestMerged}
+	mock.ExpectQuery(regexp.QuoteMeta(CreateSubscriptionQuery)).
+		WithArgs("https://example.com/hook", "0123456789abcdef", pq.Array(events)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(7, createdAt))
+
+	got, err := repo.CreateSubscription(ctx, &entity.WebhookSubscriptionCreate{Url: "https://example.com/hook", Secret: "0123456789abcdef", EventTypes: events})
+	require.NoError(t, err)
+	assert.Equal(t, &entity.WebhookSubscription{Id: 7, Url: "https://example.com/hook", Secret: "0123456789abcdef", EventTypes: events, CreatedAt: createdAt}, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteSubscription_NotFound(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectExec(regexp.QuoteMeta(DeleteSubscriptionQuery)).
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.DeleteSubscription(ctx, 7)
+	assert.ErrorIs(t, err, entity.ErrWebhookNotFound)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSubscriptionsByEvent_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "url", "secret", "event_types", "created_at"}).
+		AddRow(1, "https://example.com/all", "secret-one-abcdef", "{}", createdAt).
+		AddRow(2, "https://example.com/merged", "secret-two-abcdef", "{pull_request.merged}", createdAt)
+	mock.ExpectQuery(regexp.QuoteMeta(GetSubscriptionsByEventQuery)).
+		WithArgs(entity.EventTypePullRequestMerged).
+		WillReturnRows(rows)
+
+	got, err := repo.GetSubscriptionsByEvent(ctx, entity.EventTypePullRequestMerged)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	assert.Equal(t, "secret-one-abcdef", got[0].Secret)
+	assert.Empty(t, got[0].EventTypes)
+	assert.Equal(t, []string{entity.EventTypePullRequestMerged}, got[1].EventTypes)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListDeliveries_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	rows := sqlmock.NewRows([]string{"id", "subscription_id", "event_type", "idempotency_key", "payload", "attempt", "status_code", "error", "success", "created_at"}).
+		AddRow(11, 7, entity.EventTypePullRequestMerged, "key-1", []byte(`{"type":"pull_request.merged"}`), 2, 200, "", true, createdAt).
+		AddRow(10, 7, entity.EventTypePullRequestMerged, "key-1", []byte(`{"type":"pull_request.merged"}`), 1, nil, "connection refused", false, createdAt)
+	mock.ExpectQuery(regexp.QuoteMeta(ListDeliveriesQuery)).
+		WithArgs(int64(7), 50).
+		WillReturnRows(rows)
+
+	got, err := repo.ListDeliveries(ctx, 7, 50)
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	require.NotNil(t, got[0].StatusCode)
+	assert.Equal(t, 200, *got[0].StatusCode)
+	assert.Nil(t, got[1].StatusCode)
+	assert.Equal(t, "connection refused", got[1].Error)
+	assert.Equal(t, "key-1", got[1].IdempotencyKey)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordDelivery_DBError(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	dbErr := errors.New("db failure")
+	mock.ExpectExec(regexp.QuoteMeta(RecordDeliveryQuery)).
+		WillReturnError(dbErr)
+
+	err := repo.RecordDelivery(ctx, &entity.WebhookDelivery{SubscriptionId: 7, EventType: entity.EventTypePullRequestMerged, Payload: []byte(`{}`), Attempt: 1})
+	assert.EqualError(t, err, dbErr.Error())
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDeliveryStates_Success(t *testing.T) {
+	db, mock, repo := setupTest(t)
+	defer db.Close()
+	ctx := getTestContext()
+
+	mock.ExpectQuery(regexp.QuoteMeta(GetDeliveryStatesQuery)).
+		WithArgs("key-1").
+		WillReturnRows(sqlmock.NewRows([]string{"subscription_id", "delivered", "attempts"}).AddRow(1, true, 2).AddRow(3, false, 1))
+
+	got, err := repo.GetDeliveryStates(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]*entity.WebhookDeliveryState{
+		1: {Delivered: true, Attempts: 2},
+		3: {Delivered: false, Attempts: 1},
+	}, got)
+
+	require.NoError(t, mock.ExpectationsWereMet())
+}
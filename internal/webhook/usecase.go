@@ -0,0 +1,14 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+)
+
+type IUsecase interface {
+	CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscriptionCreate) (*entity.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id int64) error
+	ListDeliveries(ctx context.Context, subscriptionId int64, limit int) ([]*entity.WebhookDelivery, error)
+}
@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/webhook"
+)
+
+type usecase struct {
+	WebhookRepository webhook.IRepository
+}
+
+func NewUsecase(webhookRepository webhook.IRepository) webhook.IUsecase {
+	return &usecase{
+		WebhookRepository: webhookRepository,
+	}
+}
+
+func (u *usecase) CreateSubscription(ctx context.Context, subscription *entity.WebhookSubscriptionCreate) (*entity.WebhookSubscription, error) {
+	if subscription.EventTypes == nil {
+		subscription.EventTypes = []string{}
+	}
+	for _, eventType := range subscription.EventTypes {
+		if !slices.Contains(entity.DomainEventTypes, eventType) {
+			return nil, entity.ErrInvalidWebhookEvent
+		}
+	}
+
+	return u.WebhookRepository.CreateSubscription(ctx, subscription)
+}
+
+func (u *usecase) ListSubscriptions(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	return u.WebhookRepository.ListSubscriptions(ctx)
+}
+
+func (u *usecase) DeleteSubscription(ctx context.Context, id int64) error {
+	return u.WebhookRepository.DeleteSubscription(ctx, id)
+}
+
+func (u *usecase) ListDeliveries(ctx context.Context, subscriptionId int64, limit int) ([]*entity.WebhookDelivery, error) {
+	if limit == 0 {
+		limit = entity.DefaultPageLimit
+	}
+	if limit < 0 || limit > entity.MaxPageLimit {
+		return nil, entity.ErrInvalidPagination
+	}
+
+	isExist, err := u.WebhookRepository.CheckSubscriptionExist(ctx, subscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	if !isExist {
+		return nil, entity.ErrWebhookNotFound
+	}
+
+	return u.WebhookRepository.ListDeliveries(ctx, subscriptionId, limit)
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Mockird31/avito_tech/internal/entity"
+	"github.com/Mockird31/avito_tech/internal/webhook"
+	mock_webhook "github.com/Mockird31/avito_tech/mocks/webhook"
+	loggerPkg "github.com/Mockird31/avito_tech/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupTest(t *testing.T) (webhook.IUsecase, *mock_webhook.MockIRepository) {
+	repo := mock_webhook.NewMockIRepository(t)
+	return NewUsecase(repo), repo
+}
+
+func getTestContext() context.Context {
+	logger := zap.NewNop()
+	ctx := context.Background()
+	return loggerPkg.LoggerToContext(ctx, logger.Sugar())
+}
+
+func TestCreateSubscription_UnknownEvent(t *testing.T) {
+	uc, _ := setupTest(t)
+	ctx := getTestContext()
+
+	got, err := uc.CreateSubscription(ctx, &entity.WebhookSubscriptionCreate{Url: "https://example.com/hook", Secret: "0123456789abcdef", EventTypes: []string{"pull_request.deleted"}})
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrInvalidWebhookEvent)
+}
+
+func TestCreateSubscription_AllEventsByDefault(t *testing.T) {
+	uc, repo := setupTest(t)
+	ctx := getTestContext()
+
+	req := &entity.WebhookSubscriptionCreate{Url: "https://example.com/hook", Secret: "0123456789abcdef"}
+	want := &entity.WebhookSubscription{Id: 1, Url: req.Url, EventTypes: []string{}}
+	repo.EXPECT().
+		CreateSubscription(mock.Anything, &entity.WebhookSubscriptionCreate{Url: req.Url, Secret: req.Secret, EventTypes: []string{}}).
+		Return(want, nil)
+
+	got, err := uc.CreateSubscription(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestListDeliveries_InvalidLimit(t *testing.T) {
+	uc, _ := setupTest(t)
+	ctx := getTestContext()
+
+	got, err := uc.ListDeliveries(ctx, 1, entity.MaxPageLimit+1)
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrInvalidPagination)
+}
+
+func TestListDeliveries_NotFound(t *testing.T) {
+	uc, repo := setupTest(t)
+	ctx := getTestContext()
+
+	repo.EXPECT().
+		CheckSubscriptionExist(mock.Anything, int64(1)).
+		Return(false, nil)
+
+	got, err := uc.ListDeliveries(ctx, 1, 0)
+	require.Error(t, err)
+	assert.Nil(t, got)
+	assert.ErrorIs(t, err, entity.ErrWebhookNotFound)
+}
+
+func TestListDeliveries_DefaultLimit(t *testing.T) {
+	uc, repo := setupTest(t)
+	ctx := getTestContext()
+
+	repo.EXPECT().
+		CheckSubscriptionExist(mock.Anything, int64(1)).
+		Return(true, nil)
+	repo.EXPECT().
+		ListDeliveries(mock.Anything, int64(1), entity.DefaultPageLimit).
+		Return([]*entity.WebhookDelivery{}, nil)
+
+	got, err := uc.ListDeliveries(ctx, 1, 0)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,5 @@
+-- Лимит одновременно открытых ревью: значение пользователя приоритетнее значения команды, NULL - без ограничений
+ALTER TABLE team ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER DEFAULT NULL CHECK (max_open_reviews > 0);
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER DEFAULT NULL CHECK (max_open_reviews > 0);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_status ON pull_request(status);
@@ -0,0 +1,15 @@
+-- Пользователи удаляются мягко: строка остается, чтобы история pull request'ов и статистика не терялись
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ DEFAULT NULL;
+
+-- Физическое удаление пользователя больше не должно каскадно стирать его pull request'ы
+ALTER TABLE pull_request DROP CONSTRAINT IF EXISTS pull_request_author_id_fkey;
+ALTER TABLE pull_request
+    ADD CONSTRAINT pull_request_author_id_fkey
+    FOREIGN KEY (author_id) REFERENCES "user"(id) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+ALTER TABLE pull_request_reviewers DROP CONSTRAINT IF EXISTS pull_request_reviewers_reviewer_id_fkey;
+ALTER TABLE pull_request_reviewers
+    ADD CONSTRAINT pull_request_reviewers_reviewer_id_fkey
+    FOREIGN KEY (reviewer_id) REFERENCES "user"(id) ON DELETE RESTRICT ON UPDATE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_user_deleted_at ON "user"(deleted_at);
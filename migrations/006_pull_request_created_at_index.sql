@@ -0,0 +1,2 @@
+-- Постраничная выдача списков pull request'ов идет по (created_at, id)
+CREATE INDEX IF NOT EXISTS idx_pull_request_created_at_id ON pull_request(created_at, id);
@@ -0,0 +1,4 @@
+-- Поиск pull request'ов по подстроке в названии (ILIKE '%...%') не может использовать B-tree индекс idx_pull_request_name
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_name_trgm ON pull_request USING gin (name gin_trgm_ops);
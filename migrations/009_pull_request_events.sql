@@ -0,0 +1,10 @@
+-- Журнал изменений состава ревьюверов pull request'а
+CREATE TABLE IF NOT EXISTS pull_request_event (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_request(id) ON DELETE CASCADE,
+    event_type TEXT NOT NULL,
+    reviewer_id TEXT REFERENCES "user"(id) ON DELETE RESTRICT ON UPDATE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_event_pr ON pull_request_event(pull_request_id, created_at);
@@ -0,0 +1,6 @@
+-- SLA ревью команды: через сколько часов после назначения ревью считается просроченным, NULL - без SLA
+ALTER TABLE team ADD COLUMN IF NOT EXISTS review_sla_hours INTEGER DEFAULT NULL CHECK (review_sla_hours > 0);
+ALTER TABLE team ADD COLUMN IF NOT EXISTS review_sla_auto_reassign BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS overdue_at TIMESTAMPTZ DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_pull_request_reviewers_overdue ON pull_request_reviewers(overdue_at) WHERE overdue_at IS NOT NULL;
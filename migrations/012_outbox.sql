@@ -0,0 +1,20 @@
+-- Доменные события пишутся в outbox в той же транзакции, что и изменение данных,
+-- и публикуются relay'ем. idempotency_key передается получателям для дедупликации.
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    idempotency_key UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at, id) WHERE published_at IS NULL;
+
+-- Повторная публикация события не доставляет его подпискам, которые уже ответили успехом
+ALTER TABLE webhook_delivery ADD COLUMN IF NOT EXISTS idempotency_key UUID DEFAULT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_idempotency_key ON webhook_delivery(idempotency_key) WHERE success;
@@ -0,0 +1,8 @@
+-- Соответствие пользователей GitHub / GitLab пользователям сервиса для входящих вебхуков
+CREATE TABLE IF NOT EXISTS external_user_mapping (
+    provider TEXT NOT NULL CHECK (provider IN ('github', 'gitlab')),
+    external_username TEXT NOT NULL,
+    user_id TEXT NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (provider, external_username)
+);
@@ -0,0 +1,4 @@
+-- Состояние синхронизации назначенных ревьюверов с pull request'ом во внешнем хостинге, NULL - не синхронизировался
+ALTER TABLE pull_request ADD COLUMN IF NOT EXISTS reviewers_sync_status TEXT DEFAULT NULL CHECK (reviewers_sync_status IN ('synced', 'failed'));
+ALTER TABLE pull_request ADD COLUMN IF NOT EXISTS reviewers_sync_error TEXT NOT NULL DEFAULT '';
+ALTER TABLE pull_request ADD COLUMN IF NOT EXISTS reviewers_sync_updated_at TIMESTAMPTZ DEFAULT NULL;
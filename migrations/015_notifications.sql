@@ -0,0 +1,5 @@
+-- Пользователи, отказавшиеся от уведомлений в чат
+CREATE TABLE IF NOT EXISTS notification_opt_out (
+    user_id TEXT PRIMARY KEY REFERENCES "user"(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,4 @@
+-- Сколько ревьюверов может быть на pull request'е автора из команды при ручном добавлении и снятии
+ALTER TABLE team ADD COLUMN IF NOT EXISTS min_reviewers INTEGER NOT NULL DEFAULT 1 CHECK (min_reviewers >= 0);
+ALTER TABLE team ADD COLUMN IF NOT EXISTS max_reviewers INTEGER NOT NULL DEFAULT 5 CHECK (max_reviewers > 0);
+ALTER TABLE team ADD CONSTRAINT team_reviewer_limits_check CHECK (min_reviewers <= max_reviewers);
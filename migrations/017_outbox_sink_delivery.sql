@@ -0,0 +1,7 @@
+-- Sink'и, которые уже приняли событие: при повторе после ошибки одного sink'а остальные событие повторно не получают
+CREATE TABLE IF NOT EXISTS outbox_sink_delivery (
+    outbox_id BIGINT NOT NULL REFERENCES outbox(id) ON DELETE CASCADE,
+    sink TEXT NOT NULL,
+    delivered_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (outbox_id, sink)
+);
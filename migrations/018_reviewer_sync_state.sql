@@ -0,0 +1,4 @@
+-- Состояние синхронизации каждого назначенного ревьювера с внешним хостингом, NULL - еще не синхронизировался.
+-- Статус pull request'а в reviewers_sync_status собирается из состояний его текущих ревьюверов
+ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS sync_status TEXT DEFAULT NULL CHECK (sync_status IN ('synced', 'failed'));
+ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS sync_error TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,5 @@
+-- Дни, за которые дайджест уже разослан: строку вставляет экземпляр сервиса, взявший рассылку на себя
+CREATE TABLE IF NOT EXISTS notification_digest_run (
+    day DATE PRIMARY KEY,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
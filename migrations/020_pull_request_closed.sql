@@ -0,0 +1,3 @@
+-- Pull request'ы, закрытые во внешнем хостинге без merge
+ALTER TABLE pull_request DROP CONSTRAINT IF EXISTS pull_request_status_check;
+ALTER TABLE pull_request ADD CONSTRAINT pull_request_status_check CHECK (status IN ('OPEN', 'MERGED', 'CLOSED'));
@@ -0,0 +1,5 @@
+-- Числовой идентификатор пользователя хостинга: GitLab передает автора merge request'а только им
+ALTER TABLE external_user_mapping ADD COLUMN IF NOT EXISTS external_user_id BIGINT;
+CREATE UNIQUE INDEX IF NOT EXISTS external_user_mapping_external_user_id_idx
+    ON external_user_mapping (provider, external_user_id)
+    WHERE external_user_id IS NOT NULL;
@@ -9,10 +9,13 @@ import (
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
-func ConnectPostgres(cfg config.PostgresConfig) (*sql.DB, error) {
-	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable", cfg.PostgresHost, cfg.PostgresPort, cfg.PostgresUser, cfg.PostgresPassword, cfg.PostgresDB)
+// DSN - строка подключения в формате key=value, ее понимают и pgx, и lib/pq.
+func DSN(cfg config.PostgresConfig) string {
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable", cfg.PostgresHost, cfg.PostgresPort, cfg.PostgresUser, cfg.PostgresPassword, cfg.PostgresDB)
+}
 
-	db, err := sql.Open("pgx", dsn)
+func ConnectPostgres(cfg config.PostgresConfig) (*sql.DB, error) {
+	db, err := sql.Open("pgx", DSN(cfg))
 	if err != nil {
 		return nil, err
 	}
@@ -22,8 +25,6 @@ func ConnectPostgres(cfg config.PostgresConfig) (*sql.DB, error) {
 		return nil, err
 	}
 
-	fmt.Println("Connected to Postgres")
-
 	return db, nil
 }
 
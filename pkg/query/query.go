@@ -0,0 +1,55 @@
+package query
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+var (
+	ErrInvalidParam = errors.New("invalid query parameter")
+)
+
+// Int возвращает 0, если параметр не передан.
+func Int(values url.Values, key string) (int, error) {
+	raw := values.Get(key)
+	if raw == "" {
+		return 0, nil
+	}
+
+	v, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidParam, key)
+	}
+	return v, nil
+}
+
+// Bool возвращает nil, если параметр не передан.
+func Bool(values url.Values, key string) (*bool, error) {
+	raw := values.Get(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	v, err := strconv.ParseBool(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidParam, key)
+	}
+	return &v, nil
+}
+
+// Time ожидает время в формате RFC3339 и возвращает nil, если параметр не передан.
+func Time(values url.Values, key string) (*time.Time, error) {
+	raw := values.Get(key)
+	if raw == "" {
+		return nil, nil
+	}
+
+	v, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidParam, key)
+	}
+	return &v, nil
+}